@@ -62,6 +62,17 @@ func Example() {
 	antlr.ParseTreeWalkerDefault.Walk(&exampleListener{}, tree)
 }
 
+// generated returns the inputs written by internal/tools/generate.go to cover
+// the rules the examples don't, relative to the root of this repository like
+// the examples.
+func generated() []string {
+	files, _ := filepath.Glob(filepath.Join("testdata", "generated", "*"))
+	for i, file := range files {
+		files[i] = filepath.Join("asn", file)
+	}
+	return files
+}
+
 func newCharStream(filename string) (antlr.CharStream, error) {
 	var input antlr.CharStream
 	input, err := antlr.NewFileStream(filepath.Join("..", filename))
@@ -73,7 +84,7 @@ func newCharStream(filename string) (antlr.CharStream, error) {
 }
 
 func TestASNLexer(t *testing.T) {
-	for _, file := range examples {
+	for _, file := range append(examples, generated()...) {
 		input, err := newCharStream(file)
 		if err != nil {
 			t.Errorf("Failed to open example file: %s", err)
@@ -101,7 +112,7 @@ func TestASNLexer(t *testing.T) {
 func TestASNParser(t *testing.T) {
 	// TODO(bramp): Run this test with and without p.BuildParseTrees
 
-	for _, file := range examples {
+	for _, file := range append(examples, generated()...) {
 		input, err := newCharStream(file)
 		if err != nil {
 			t.Errorf("Failed to open example file: %s", err)
@@ -0,0 +1,186 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"bramp.net/antlr4/grammars"
+)
+
+// memberSep separates the archive's name from the member's name, for example
+// "dump.tar.gz!data/users.sql".
+const memberSep = "!"
+
+// member returns the name of the innermost archive member, or the file's name
+// if the input isn't in an archive.
+func (in *input) member() string {
+	return in.name[strings.LastIndex(in.name, memberSep)+1:]
+}
+
+// included returns true if the input's base name matches the pattern, such as
+// "*.sql". An empty pattern matches every input.
+func (in *input) included(pattern string) (bool, error) {
+	if pattern == "" {
+		return true, nil
+	}
+	return path.Match(pattern, path.Base(filepath.ToSlash(in.member())))
+}
+
+// grammar returns the grammar to parse the input with, or nil if the input is
+// a member of an archive that should be skipped. Archives often hold files in
+// many languages, and files that aren't source at all, such as a README, so
+// only members whose extension matches a grammar's examples are parsed. If the
+// -grammar flag chose g, only the members matching g are parsed, otherwise each
+// is parsed with the first grammar matching it. g is nil if the flag wasn't
+// set.
+func (in *input) grammar(g *grammars.Grammar) (*grammars.Grammar, error) {
+	if !in.inArchive {
+		if g == nil {
+			return nil, fmt.Errorf("%s: no grammar chosen, set the -grammar flag", in.name)
+		}
+		return g, nil
+	}
+
+	candidates := grammars.ByExtension(path.Ext(in.member()))
+	if g == nil {
+		if len(candidates) == 0 {
+			return nil, nil
+		}
+		return candidates[0], nil
+	}
+	for _, c := range candidates {
+		if c == g {
+			return g, nil
+		}
+	}
+	return nil, nil
+}
+
+// walkInput calls fn with the input, or if the input is a gzip, zip or tar
+// archive, with each of the files inside it. Archives inside archives are
+// also walked.
+func walkInput(in *input, fn func(in *input) error) error {
+	name := strings.ToLower(in.name)
+	switch {
+	case strings.HasSuffix(name, ".tar.gz"), strings.HasSuffix(name, ".tgz"):
+		r, err := gzip.NewReader(bytes.NewReader(in.data))
+		if err != nil {
+			return err
+		}
+		return walkTar(in.name, r, fn)
+
+	case strings.HasSuffix(name, ".tar"):
+		return walkTar(in.name, bytes.NewReader(in.data), fn)
+
+	case strings.HasSuffix(name, ".zip"):
+		return walkZip(in.name, in.data, fn)
+
+	case strings.HasSuffix(name, ".gz"):
+		r, err := gzip.NewReader(bytes.NewReader(in.data))
+		if err != nil {
+			return err
+		}
+		data, err := ioutil.ReadAll(r)
+		if err != nil {
+			return err
+		}
+		member := strings.TrimSuffix(path.Base(in.name), path.Ext(in.name))
+		return walkInput(&input{
+			name:      in.name + memberSep + member,
+			inArchive: true,
+			data:      data,
+		}, fn)
+	}
+
+	return fn(in)
+}
+
+func walkTar(name string, r io.Reader, fn func(in *input) error) error {
+	tr := tar.NewReader(r)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if hdr.Typeflag != tar.TypeReg && hdr.Typeflag != tar.TypeRegA {
+			continue
+		}
+
+		data, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return err
+		}
+
+		err = walkInput(&input{
+			name:      name + memberSep + hdr.Name,
+			inArchive: true,
+			data:      data,
+		}, fn)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func walkZip(name string, data []byte, fn func(in *input) error) error {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return err
+	}
+
+	for _, f := range zr.File {
+		if f.FileInfo().IsDir() {
+			continue
+		}
+
+		data, err := readZipFile(f)
+		if err != nil {
+			return err
+		}
+
+		err = walkInput(&input{
+			name:      name + memberSep + f.Name,
+			inArchive: true,
+			data:      data,
+		}, fn)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readZipFile(f *zip.File) ([]byte, error) {
+	r, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer r.Close()
+
+	return ioutil.ReadAll(r)
+}
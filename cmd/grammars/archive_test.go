@@ -0,0 +1,161 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"bytes"
+	"compress/gzip"
+	"testing"
+
+	"bramp.net/antlr4/grammars"
+	"github.com/kylelemons/godebug/pretty"
+)
+
+type file struct {
+	name string
+	data string
+}
+
+func makeTar(t *testing.T, files []file) []byte {
+	var buf bytes.Buffer
+	w := tar.NewWriter(&buf)
+	for _, f := range files {
+		hdr := &tar.Header{Name: f.name, Mode: 0644, Size: int64(len(f.data)), Typeflag: tar.TypeReg}
+		if err := w.WriteHeader(hdr); err != nil {
+			t.Fatalf("tar.WriteHeader(%q) err = %s", f.name, err)
+		}
+		w.Write([]byte(f.data))
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("tar.Close() err = %s", err)
+	}
+	return buf.Bytes()
+}
+
+func makeZip(t *testing.T, files []file) []byte {
+	var buf bytes.Buffer
+	w := zip.NewWriter(&buf)
+	for _, f := range files {
+		fw, err := w.Create(f.name)
+		if err != nil {
+			t.Fatalf("zip.Create(%q) err = %s", f.name, err)
+		}
+		fw.Write([]byte(f.data))
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("zip.Close() err = %s", err)
+	}
+	return buf.Bytes()
+}
+
+func makeGzip(t *testing.T, data []byte) []byte {
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	w.Write(data)
+	if err := w.Close(); err != nil {
+		t.Fatalf("gzip.Close() err = %s", err)
+	}
+	return buf.Bytes()
+}
+
+func TestWalkInput(t *testing.T) {
+	files := []file{
+		{"a.json", `{"a": 1}`},
+		{"dir/b.json", `[1, 2]`},
+	}
+	tarball := makeTar(t, files)
+
+	tests := []struct {
+		name string
+		data []byte
+		want []file
+	}{
+		{"plain.json", []byte(`{}`), []file{{"plain.json", `{}`}}},
+		{"one.json.gz", makeGzip(t, []byte(`[]`)), []file{{"one.json.gz!one.json", `[]`}}},
+		{"x.tar", tarball, []file{{"x.tar!a.json", `{"a": 1}`}, {"x.tar!dir/b.json", `[1, 2]`}}},
+		{"x.tar.gz", makeGzip(t, tarball), []file{{"x.tar.gz!a.json", `{"a": 1}`}, {"x.tar.gz!dir/b.json", `[1, 2]`}}},
+		{"x.zip", makeZip(t, files), []file{{"x.zip!a.json", `{"a": 1}`}, {"x.zip!dir/b.json", `[1, 2]`}}},
+
+		// Archives inside archives
+		{"y.zip", makeZip(t, []file{{"x.tar", string(tarball)}}), []file{{"y.zip!x.tar!a.json", `{"a": 1}`}, {"y.zip!x.tar!dir/b.json", `[1, 2]`}}},
+	}
+
+	for _, test := range tests {
+		var got []file
+		err := walkInput(&input{name: test.name, data: test.data}, func(in *input) error {
+			got = append(got, file{in.name, string(in.data)})
+			return nil
+		})
+		if err != nil {
+			t.Errorf("walkInput(%q) err = %s, want nil", test.name, err)
+			continue
+		}
+
+		if diff := pretty.Compare(got, test.want); diff != "" {
+			t.Errorf("walkInput(%q) diff: (-got +want)\n%s", test.name, diff)
+		}
+	}
+}
+
+func TestIncluded(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		want    bool
+	}{
+		{"dir/a.sql", "", true},
+		{"dir/a.sql", "*.sql", true},
+		{"dir/a.json", "*.sql", false},
+		{"x.zip!a.sql", "*.sql", true},
+		{"x.zip!dir/a.sql", "*.sql", true},
+		{"a.sql.zip!b.json", "*.sql", false},
+	}
+
+	for _, test := range tests {
+		got, err := (&input{name: test.name}).included(test.pattern)
+		if err != nil || got != test.want {
+			t.Errorf("included(%q, %q) = %t, %v, want %t, nil", test.name, test.pattern, got, err, test.want)
+		}
+	}
+}
+
+func TestInputGrammar(t *testing.T) {
+	json := grammars.Lookup("json")
+	xml := grammars.Lookup("xml")
+
+	tests := []struct {
+		in      *input
+		grammar *grammars.Grammar
+		want    *grammars.Grammar
+		wantErr bool
+	}{
+		{&input{name: "a.json"}, xml, xml, false}, // The flag wins for files outside archives.
+		{&input{name: "a.json"}, nil, nil, true},
+		{&input{name: "x.zip!a.json", inArchive: true}, json, json, false},
+		{&input{name: "x.zip!a.json", inArchive: true}, xml, nil, false}, // Skipped, as it doesn't match the flag.
+		{&input{name: "x.zip!a.unknown", inArchive: true}, xml, nil, false},
+		{&input{name: "x.zip!a.json", inArchive: true}, nil, json, false},
+		{&input{name: "x.zip!README", inArchive: true}, nil, nil, false}, // Skipped, without stopping the walk.
+	}
+
+	for _, test := range tests {
+		got, err := test.in.grammar(test.grammar)
+		if got != test.want || (err != nil) != test.wantErr {
+			t.Errorf("%q.grammar(%v) = %v, %v, want %v, error %t", test.in.name, test.grammar, got, err, test.want, test.wantErr)
+		}
+	}
+}
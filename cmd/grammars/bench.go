@@ -0,0 +1,230 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"go/build"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"text/tabwriter"
+	"time"
+
+	"bramp.net/antlr4/grammars"
+)
+
+var benchCmd = newCommand("bench", "[file, directory or archive]...", "measure how quickly grammars parse their examples, or the given files")
+
+var (
+	benchGrammar = benchCmd.flags.String("grammar", "", "name of the grammar to benchmark (default all grammars with examples)")
+	benchInclude = benchCmd.flags.String("include", "", `only benchmark files whose name matches this pattern, e.g "*.sql"`)
+	benchCount   = benchCmd.flags.Int("count", 5, "number of times to parse each file")
+	benchFormat  = benchCmd.flags.String("format", "text", `output format, "text", "csv" or "json"`)
+	benchRoot    = benchCmd.flags.String("root", "", "root of the bramp.net/antlr4 checkout, used to find the examples (default found in GOPATH)")
+)
+
+func init() {
+	benchCmd.run = runBench
+}
+
+// benchResult is the measurements for one grammar.
+type benchResult struct {
+	Grammar   string    `json:"grammar"`
+	GoVersion string    `json:"go_version"`
+	Time      time.Time `json:"time"`
+
+	Files  int   `json:"files"`
+	Bytes  int64 `json:"bytes"`
+	Errors int   `json:"errors"` // Number of syntax errors (in a single pass)
+
+	MBPerSec float64       `json:"mb_per_sec"`
+	P50      time.Duration `json:"p50_ns"` // Latency of parsing a single file
+	P99      time.Duration `json:"p99_ns"`
+}
+
+// percentile returns the p-th percentile (0-100) of the sorted durations,
+// using the nearest-rank method.
+func percentile(sorted []time.Duration, p float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(p/100*float64(len(sorted))+0.5) - 1
+	if rank < 0 {
+		rank = 0
+	}
+	if rank >= len(sorted) {
+		rank = len(sorted) - 1
+	}
+	return sorted[rank]
+}
+
+// bench parses each input count times with the grammar.
+func bench(g *grammars.Grammar, inputs []*input, count int) (*benchResult, error) {
+	result := &benchResult{
+		Grammar:   g.Name,
+		GoVersion: runtime.Version(),
+		Time:      time.Now().UTC(),
+		Files:     len(inputs),
+	}
+
+	var latencies []time.Duration
+	var total time.Duration
+	for _, in := range inputs {
+		result.Bytes += int64(len(in.data))
+
+		for i := 0; i < count; i++ {
+			start := time.Now()
+			r, err := in.parse(g)
+			d := time.Since(start)
+			if err != nil {
+				return nil, err
+			}
+			if i == 0 {
+				result.Errors += len(r.Errors)
+			}
+
+			latencies = append(latencies, d)
+			total += d
+		}
+	}
+
+	sort.Slice(latencies, func(i, j int) bool {
+		return latencies[i] < latencies[j]
+	})
+	if total > 0 {
+		result.MBPerSec = float64(result.Bytes*int64(count)) / (1 << 20) / total.Seconds()
+	}
+	result.P50 = percentile(latencies, 50)
+	result.P99 = percentile(latencies, 99)
+
+	return result, nil
+}
+
+// exampleInputs reads the grammar's examples from the checkout at root.
+func exampleInputs(g *grammars.Grammar, root string) ([]*input, error) {
+	var inputs []*input
+	for _, example := range g.Examples {
+		filename := filepath.Join(root, example)
+		data, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return nil, err
+		}
+		inputs = append(inputs, &input{name: filename, data: data})
+	}
+	return inputs, nil
+}
+
+func runBench(args []string) error {
+	if *benchCount < 1 {
+		return fmt.Errorf("-count must be at least 1")
+	}
+	// Check the format before spending minutes benchmarking.
+	if err := writeBenchResults(ioutil.Discard, *benchFormat, nil); err != nil {
+		return err
+	}
+
+	var gs []*grammars.Grammar
+	if *benchGrammar != "" {
+		g, err := lookupGrammar(*benchGrammar)
+		if err != nil {
+			return err
+		}
+		gs = append(gs, g)
+	} else if len(args) > 0 {
+		return fmt.Errorf("the -grammar flag is required when files are given")
+	} else {
+		for _, g := range grammars.All() {
+			if g.HasParser() && len(g.Examples) > 0 {
+				gs = append(gs, g)
+			}
+		}
+	}
+
+	root := *benchRoot
+	if root == "" && len(args) == 0 {
+		pkg, err := build.Import("bramp.net/antlr4", "", build.FindOnly)
+		if err != nil {
+			return fmt.Errorf("unable to find the examples, use -root: %s", err)
+		}
+		root = pkg.Dir
+	}
+
+	var results []*benchResult
+	for _, g := range gs {
+		var inputs []*input
+		var err error
+		if len(args) > 0 {
+			err = walkInputs(args, func(in *input) error {
+				if ok, err := in.included(*benchInclude); err != nil || !ok {
+					return err
+				}
+				inputs = append(inputs, in)
+				return nil
+			})
+		} else {
+			inputs, err = exampleInputs(g, root)
+		}
+		if err != nil {
+			return err
+		}
+
+		result, err := bench(g, inputs, *benchCount)
+		if err != nil {
+			return fmt.Errorf("%s: %s", g.Name, err)
+		}
+		results = append(results, result)
+	}
+
+	return writeBenchResults(os.Stdout, *benchFormat, results)
+}
+
+func writeBenchResults(w io.Writer, format string, results []*benchResult) error {
+	switch format {
+	case "json":
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(results)
+
+	case "csv":
+		cw := csv.NewWriter(w)
+		cw.Write([]string{"grammar", "go_version", "time", "files", "bytes", "errors", "mb_per_sec", "p50_ns", "p99_ns"})
+		for _, r := range results {
+			cw.Write([]string{
+				r.Grammar, r.GoVersion, r.Time.Format(time.RFC3339),
+				strconv.Itoa(r.Files), strconv.FormatInt(r.Bytes, 10), strconv.Itoa(r.Errors),
+				strconv.FormatFloat(r.MBPerSec, 'f', 3, 64),
+				strconv.FormatInt(int64(r.P50), 10), strconv.FormatInt(int64(r.P99), 10),
+			})
+		}
+		cw.Flush()
+		return cw.Error()
+
+	case "text":
+		tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+		fmt.Fprintln(tw, "Grammar\tFiles\tBytes\tErrors\tMB/s\tP50\tP99\t")
+		for _, r := range results {
+			fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%.2f\t%s\t%s\t\n", r.Grammar, r.Files, r.Bytes, r.Errors, r.MBPerSec, r.P50, r.P99)
+		}
+		return tw.Flush()
+	}
+	return fmt.Errorf("unknown format %q", format)
+}
@@ -0,0 +1,57 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPercentile(t *testing.T) {
+	var sorted []time.Duration
+	for i := 1; i <= 100; i++ {
+		sorted = append(sorted, time.Duration(i))
+	}
+
+	tests := []struct {
+		input []time.Duration
+		p     float64
+		want  time.Duration
+	}{
+		{nil, 50, 0},
+		{[]time.Duration{7}, 50, 7},
+		{[]time.Duration{7}, 99, 7},
+		{[]time.Duration{1, 2, 3}, 50, 2},
+		{sorted, 50, 50},
+		{sorted, 99, 99},
+		{sorted, 100, 100},
+	}
+
+	for _, test := range tests {
+		if got := percentile(test.input, test.p); got != test.want {
+			t.Errorf("percentile(%v, %v) = %v, want %v", test.input, test.p, got, test.want)
+		}
+	}
+}
+
+func TestRunBenchFormat(t *testing.T) {
+	defer func(format string) { *benchFormat = format }(*benchFormat)
+
+	// Fails before benchmarking every grammar's examples.
+	*benchFormat = "xml"
+	if err := runBench(nil); err == nil {
+		t.Errorf("runBench(-format %s) err = nil, want unknown format", *benchFormat)
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/build"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"bramp.net/antlr4/grammars/compat"
+)
+
+var compatCmd = newCommand("compat", "dump | diff <old.json> <new.json>", "compare two revisions of a grammar, reporting breaking changes")
+
+var (
+	compatGrammar = compatCmd.flags.String("grammar", "", "name of the grammar to dump")
+	compatRoot    = compatCmd.flags.String("root", "", "root of the bramp.net/antlr4 checkout, used to find the examples (default found in GOPATH)")
+	compatOutput  = compatCmd.flags.String("o", "", "file to write the dump to (default stdout)")
+)
+
+func init() {
+	compatCmd.run = runCompat
+}
+
+func runCompat(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf(`expected "dump" or "diff" sub-command`)
+	}
+
+	// The flags may follow the sub-command.
+	sub := args[0]
+	compatCmd.flags.Parse(args[1:])
+	args = compatCmd.flags.Args()
+
+	switch sub {
+	case "dump":
+		return compatDump(args)
+	case "diff":
+		return compatDiff(args)
+	}
+	return fmt.Errorf(`expected "dump" or "diff" sub-command, got %q`, sub)
+}
+
+// compatDump writes the signature of the grammar at this revision.
+func compatDump(args []string) error {
+	g, err := lookupGrammar(*compatGrammar)
+	if err != nil {
+		return err
+	}
+	if len(args) > 0 {
+		return fmt.Errorf("unexpected arguments %q", args)
+	}
+
+	root := *compatRoot
+	if root == "" {
+		pkg, err := build.Import("bramp.net/antlr4", "", build.FindOnly)
+		if err != nil {
+			return fmt.Errorf("unable to find the examples, use -root: %s", err)
+		}
+		root = pkg.Dir
+	}
+
+	// Examples are keyed by their path relative to root, so they match
+	// between checkouts.
+	examples := make(map[string][]byte)
+	for _, example := range g.Examples {
+		data, err := ioutil.ReadFile(filepath.Join(root, example))
+		if err != nil {
+			return err
+		}
+		examples[example] = data
+	}
+
+	s, err := compat.Take(g, examples)
+	if err != nil {
+		return err
+	}
+
+	if *compatOutput == "" {
+		return writeSignature(os.Stdout, s)
+	}
+
+	out, err := os.Create(*compatOutput)
+	if err != nil {
+		return err
+	}
+	if err := writeSignature(out, s); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+func writeSignature(w io.Writer, s *compat.Signature) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(s)
+}
+
+func readSignature(filename string) (*compat.Signature, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	s := &compat.Signature{}
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, fmt.Errorf("%s: %s", filename, err)
+	}
+	return s, nil
+}
+
+// compatDiff prints the changes between two dumps.
+func compatDiff(args []string) error {
+	if len(args) != 2 {
+		return fmt.Errorf("expected two dumps to compare")
+	}
+
+	old, err := readSignature(args[0])
+	if err != nil {
+		return err
+	}
+	new, err := readSignature(args[1])
+	if err != nil {
+		return err
+	}
+
+	r := compat.Compare(old, new)
+	for _, c := range r.Changes {
+		fmt.Println(c)
+	}
+
+	switch {
+	case r.Breaking():
+		return fmt.Errorf("%s: found breaking changes", new.Grammar)
+	case len(r.Changes) > 0:
+		fmt.Printf("%s: compatible\n", new.Grammar)
+	default:
+		fmt.Printf("%s: identical\n", new.Grammar)
+	}
+	return nil
+}
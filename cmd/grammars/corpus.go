@@ -0,0 +1,122 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"bramp.net/antlr4/grammars/coverage"
+)
+
+var corpusCmd = newCommand("corpus", "minimize <file, directory or archive>...", "reduce a corpus to a minimal set of files with the same rule coverage")
+
+var (
+	corpusGrammar = corpusCmd.flags.String("grammar", "", "name of the grammar to parse with")
+	corpusInclude = corpusCmd.flags.String("include", "", `only include files whose name matches this pattern, e.g "*.sql"`)
+	corpusOutput  = corpusCmd.flags.String("o", "", "directory to copy the minimized corpus into (default print the file names)")
+	corpusErrors  = corpusCmd.flags.Bool("keep-errors", false, "include files with syntax errors")
+)
+
+func init() {
+	corpusCmd.run = runCorpus
+}
+
+func runCorpus(args []string) error {
+	if len(args) == 0 || args[0] != "minimize" {
+		return fmt.Errorf(`expected "minimize" sub-command`)
+	}
+
+	// The flags may follow the sub-command.
+	corpusCmd.flags.Parse(args[1:])
+	args = corpusCmd.flags.Args()
+
+	g, err := lookupGrammar(*corpusGrammar)
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return fmt.Errorf("no files given")
+	}
+
+	var inputs []*coverage.Input
+	data := make(map[*coverage.Input][]byte)
+	total := make(coverage.Set)
+
+	err = walkInputs(args, func(in *input) error {
+		if ok, err := in.included(*corpusInclude); err != nil || !ok {
+			return err
+		}
+
+		result, err := in.parse(g)
+		if err != nil {
+			return err
+		}
+		if len(result.Errors) > 0 && !*corpusErrors {
+			fmt.Fprintf(os.Stderr, "%s: skipping file with %d syntax errors\n", in.name, len(result.Errors))
+			return nil
+		}
+
+		c := &coverage.Input{
+			Name:  in.name,
+			Size:  len(in.data),
+			Rules: coverage.Rules(result.Tree),
+		}
+		total.Add(c.Rules)
+		inputs = append(inputs, c)
+		data[c] = in.data
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	minimized := coverage.Minimize(inputs)
+	fmt.Fprintf(os.Stderr, "kept %d of %d files, covering %d rules\n", len(minimized), len(inputs), len(total))
+
+	if *corpusOutput == "" {
+		for _, in := range minimized {
+			fmt.Println(in.Name)
+		}
+		return nil
+	}
+
+	if err := os.MkdirAll(*corpusOutput, 0755); err != nil {
+		return err
+	}
+	used := make(map[string]bool)
+	for _, in := range minimized {
+		name := uniqueName(used, filepath.Base(strings.Replace(in.Name, memberSep, "/", -1)))
+		if err := ioutil.WriteFile(filepath.Join(*corpusOutput, name), data[in], 0644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// uniqueName returns name, or if it has already been used, name with a
+// numbered suffix, e.g "example-2.json".
+func uniqueName(used map[string]bool, name string) string {
+	ext := filepath.Ext(name)
+	base := strings.TrimSuffix(name, ext)
+	for i := 2; used[name]; i++ {
+		name = fmt.Sprintf("%s-%d%s", base, i, ext)
+	}
+	used[name] = true
+	return name
+}
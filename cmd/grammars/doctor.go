@@ -0,0 +1,214 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"go/build"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"bramp.net/antlr4/internal"
+)
+
+var doctorCmd = newCommand("doctor", "", "check the environment needed to regenerate the grammars, and suggest fixes")
+
+var (
+	doctorDir = doctorCmd.flags.String("dir", ".", "root of the bramp.net/antlr4 checkout")
+)
+
+func init() {
+	doctorCmd.run = runDoctor
+}
+
+// check is one of the doctor's checks. It returns a description of what was
+// found, and if there is a problem, a suggested fix and the error.
+type check struct {
+	name string
+	run  func(dir string) (found, fix string, err error)
+}
+
+var checks = []check{
+	{"Go", checkGo},
+	{"GOPATH", checkGopath},
+	{"Java", checkJava},
+	{"ANTLR jar", checkAntlr},
+	{"grammars-v4", checkSubmodule},
+}
+
+// goVersion matches versions such as "go1.9.2" or "go1.10".
+var goVersion = regexp.MustCompile(`go(\d+)\.(\d+)`)
+
+func checkGo(dir string) (found, fix string, err error) {
+	out, err := exec.Command("go", "version").Output()
+	if err != nil {
+		return "", "Install Go from https://golang.org/dl/ and add it to your PATH", err
+	}
+
+	version := strings.TrimSpace(string(out))
+	m := goVersion.FindStringSubmatch(version)
+	if m == nil {
+		return version, "", fmt.Errorf("unable to parse the Go version")
+	}
+
+	major, _ := strconv.Atoi(m[1])
+	minor, _ := strconv.Atoi(m[2])
+	if major == 1 && minor < 8 {
+		return version, "Upgrade Go from https://golang.org/dl/", fmt.Errorf("Go 1.8 or newer is required")
+	}
+	return version, "", nil
+}
+
+func checkGopath(dir string) (found, fix string, err error) {
+	pkg, err := build.Import("bramp.net/antlr4", "", build.FindOnly)
+	if err != nil {
+		return "", "Run: go get -d bramp.net/antlr4", fmt.Errorf("bramp.net/antlr4 is not in the GOPATH")
+	}
+
+	want, err1 := filepath.EvalSymlinks(pkg.Dir)
+	got, err2 := filepath.EvalSymlinks(dir)
+	if err1 != nil || err2 != nil || want != got {
+		return pkg.Dir, "Work from the checkout in " + filepath.Join(build.Default.GOPATH, "src", "bramp.net", "antlr4"),
+			fmt.Errorf("bramp.net/antlr4 resolves to %s, not %s", pkg.Dir, dir)
+	}
+	return pkg.Dir, "", nil
+}
+
+// javaVersion matches versions such as "1.8.0_151" or "9.0.1".
+var javaVersion = regexp.MustCompile(`version "(\d+)(?:\.(\d+))?`)
+
+func checkJava(dir string) (found, fix string, err error) {
+	// java -version prints to stderr
+	out, err := exec.Command("java", "-version").CombinedOutput()
+	if err != nil {
+		return "", "Install a Java Runtime Environment (7 or newer), and add java to your PATH", err
+	}
+
+	version := firstLine(string(out))
+	m := javaVersion.FindStringSubmatch(version)
+	if m == nil {
+		return version, "", fmt.Errorf("unable to parse the Java version")
+	}
+
+	// Before Java 9, versions were reported as 1.x
+	major, _ := strconv.Atoi(m[1])
+	if major == 1 {
+		major, _ = strconv.Atoi(m[2])
+	}
+	if major < 7 {
+		return version, "Upgrade Java", fmt.Errorf("ANTLR %s requires Java 7 or newer", internal.ANTLRVersion)
+	}
+	return version, "", nil
+}
+
+func checkAntlr(dir string) (found, fix string, err error) {
+	jar := internal.ANTLRJar()
+	fix = "Run: mvn dependency:get -Dartifact=org.antlr:antlr4:" + internal.ANTLRVersion + ":jar:complete"
+
+	f, err := os.Open(jar)
+	if err != nil {
+		return "", fix, err
+	}
+	defer f.Close()
+
+	h := sha1.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return jar, fix, err
+	}
+	sum := hex.EncodeToString(h.Sum(nil))
+
+	// Maven stores the expected hash next to the jar.
+	want, err := ioutil.ReadFile(jar + ".sha1")
+	if err != nil {
+		return fmt.Sprintf("%s (sha1 %s, unverified)", jar, sum), "", nil
+	}
+	if fields := strings.Fields(string(want)); len(fields) == 0 || fields[0] != sum {
+		return jar, "Delete the jar, and " + fix, fmt.Errorf("sha1 %s does not match %s.sha1, the jar may be corrupt", sum, jar)
+	}
+	return fmt.Sprintf("%s (sha1 %s)", jar, sum), "", nil
+}
+
+func checkSubmodule(dir string) (found, fix string, err error) {
+	// grammars-v4 may have been downloaded by "make fetch" instead.
+	if commit, err := ioutil.ReadFile(filepath.Join(dir, "grammars-v4", ".fetched")); err == nil {
+		return "downloaded at " + strings.TrimSpace(string(commit)), "", nil
+	}
+
+	fix = "Run: git submodule update --init grammars-v4 (or make fetch, to download it without git)"
+
+	cmd := exec.Command("git", "submodule", "status", "grammars-v4")
+	cmd.Dir = dir
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		return "", "Run the doctor from a git checkout of bramp.net/antlr4", fmt.Errorf("git submodule status failed: %s", bytes.TrimSpace(out))
+	}
+
+	status := strings.TrimSpace(string(out))
+	if status == "" {
+		return "", fix, fmt.Errorf("grammars-v4 is not a submodule")
+	}
+
+	switch out[0] {
+	case '-':
+		return status, fix, fmt.Errorf("submodule is not initialised")
+	case '+':
+		return status, fix, fmt.Errorf("submodule is not at the commit recorded in this repository")
+	case 'U':
+		return status, "Resolve the conflicts in grammars-v4", fmt.Errorf("submodule has merge conflicts")
+	}
+	return status, "", nil
+}
+
+func runDoctor(args []string) error {
+	if len(args) != 0 {
+		return fmt.Errorf("unexpected arguments %q", args)
+	}
+
+	dir, err := filepath.Abs(*doctorDir)
+	if err != nil {
+		return err
+	}
+
+	problems := 0
+	for _, c := range checks {
+		found, fix, err := c.run(dir)
+		if err != nil {
+			problems++
+			fmt.Printf("❌ %-12s %s\n", c.name, err)
+			if found != "" {
+				fmt.Printf("   %-12s found: %s\n", "", found)
+			}
+			if fix != "" {
+				fmt.Printf("   %-12s fix: %s\n", "", fix)
+			}
+			continue
+		}
+		fmt.Printf("✅ %-12s %s\n", c.name, found)
+	}
+
+	if problems > 0 {
+		return fmt.Errorf("found %d problem(s)", problems)
+	}
+	return nil
+}
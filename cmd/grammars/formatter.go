@@ -0,0 +1,198 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"os"
+	"reflect"
+	"text/template"
+
+	"bramp.net/antlr4/grammars"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+var formatterCmd = newCommand("formatter", "", "generate the skeleton of a formatter for a grammar, with a hook for every rule")
+
+var (
+	formatterGrammar = formatterCmd.flags.String("grammar", "", "name of the grammar to generate a formatter for")
+	formatterPackage = formatterCmd.flags.String("package", "", `name of the generated package (default the grammar's name followed by "fmt")`)
+	formatterOutput  = formatterCmd.flags.String("o", "", "file to write the formatter to (default stdout)")
+)
+
+func init() {
+	formatterCmd.run = runFormatter
+}
+
+// formatterTmpl is the skeleton of a formatter. Until its hooks are filled
+// in, it writes the input back out unchanged.
+var formatterTmpl = template.Must(template.New("formatter").Parse(`// Package {{ .Package }} formats {{ .Grammar.LongName }} input.
+//
+// This file was generated by "grammars formatter" as a starting point. As
+// generated, Format returns its input unchanged: each token is written
+// preceded by the text between it and the previous token, such as whitespace
+// and comments. Fill in the hooks to change that.
+package {{ .Package }}
+
+import (
+	"bytes"
+
+	"bramp.net/antlr4/grammars"
+	"{{ .Grammar.ImportPath }}"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// Format parses the input, and returns it formatted.
+func Format(input string) (string, error) {
+	g := grammars.Lookup({{ printf "%q" .Grammar.Name }})
+	stream := antlr.NewInputStream(input)
+	result, err := g.Parse(stream)
+	if err != nil {
+		return "", err
+	}
+	if len(result.Errors) > 0 {
+		return "", result.Errors[0]
+	}
+
+	f := &formatter{input: stream}
+	antlr.ParseTreeWalkerDefault.Walk(f, result.Tree)
+	f.gap(stream.Size())
+
+	return f.out.String(), nil
+}
+
+// formatter is a antlr.ParseTreeListener, writing each token as the parse
+// tree is walked.
+type formatter struct {
+	input antlr.CharStream
+	out   bytes.Buffer
+	next  int // Offset of the next character to write
+
+	depth int // Number of rules entered
+}
+
+// VisitTerminal writes the token, preceded by the text since the previous
+// token.
+func (f *formatter) VisitTerminal(node antlr.TerminalNode) {
+	tok := node.GetSymbol()
+	if tok.GetTokenType() == antlr.TokenEOF || tok.GetStart() < 0 {
+		// EOF, or a token conjured up by error recovery.
+		return
+	}
+
+	f.gap(tok.GetStart())
+	f.out.WriteString(tok.GetText())
+	f.next = tok.GetStop() + 1
+}
+
+// VisitErrorNode writes tokens the parser didn't expect unchanged.
+func (f *formatter) VisitErrorNode(node antlr.ErrorNode) {
+	f.VisitTerminal(node)
+}
+
+// gap writes the text between the previous token and the offset, typically
+// whitespace, comments, or other text the lexer skipped.
+func (f *formatter) gap(offset int) {
+	if offset > f.next {
+		f.out.WriteString(f.input.GetText(f.next, offset-1))
+	}
+	f.next = offset
+}
+
+// EnterEveryRule is called before each rule's tokens are written.
+func (f *formatter) EnterEveryRule(ctx antlr.ParserRuleContext) {
+	f.depth++
+
+	switch ctx.GetRuleIndex() {
+{{- range .Rules }}
+	case {{ $.Grammar.Name }}.{{ $.Parser }}RULE_{{ . }}:
+		// TODO Format {{ . }}
+{{- end }}
+	}
+}
+
+// ExitEveryRule is called after each rule's tokens are written.
+func (f *formatter) ExitEveryRule(ctx antlr.ParserRuleContext) {
+	f.depth--
+
+	switch ctx.GetRuleIndex() {
+{{- range .Rules }}
+	case {{ $.Grammar.Name }}.{{ $.Parser }}RULE_{{ . }}:
+		// TODO Format {{ . }}
+{{- end }}
+	}
+}
+`))
+
+type formatterData struct {
+	Grammar *grammars.Grammar
+	Package string
+	Parser  string // Name of the Parser type, e.g "JSONParser"
+	Rules   []string
+}
+
+// generateFormatter returns the gofmt'd source of a formatter for the grammar.
+func generateFormatter(g *grammars.Grammar, pkg string) ([]byte, error) {
+	if !g.HasParser() {
+		return nil, fmt.Errorf("%s: grammar does not define a parser", g.Name)
+	}
+
+	lexer := g.NewLexer(antlr.NewInputStream(""))
+	parser := g.NewParser(antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel))
+
+	// The generated names are based on the grammar's name, e.g "JSONParser",
+	// which can differ from the registered name.
+	data := &formatterData{
+		Grammar: g,
+		Package: pkg,
+		Parser:  reflect.TypeOf(parser).Elem().Name(),
+		Rules:   parser.GetRuleNames(),
+	}
+
+	var buf bytes.Buffer
+	if err := formatterTmpl.Execute(&buf, data); err != nil {
+		return nil, err
+	}
+	return format.Source(buf.Bytes())
+}
+
+func runFormatter(args []string) error {
+	g, err := lookupGrammar(*formatterGrammar)
+	if err != nil {
+		return err
+	}
+	if len(args) > 0 {
+		return fmt.Errorf("unexpected arguments %q", args)
+	}
+
+	pkg := *formatterPackage
+	if pkg == "" {
+		pkg = g.Name + "fmt"
+	}
+
+	src, err := generateFormatter(g, pkg)
+	if err != nil {
+		return err
+	}
+
+	if *formatterOutput == "" {
+		_, err = os.Stdout.Write(src)
+		return err
+	}
+	return ioutil.WriteFile(*formatterOutput, src, 0644)
+}
@@ -0,0 +1,53 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"go/parser"
+	"go/token"
+	"strings"
+	"testing"
+
+	"bramp.net/antlr4/grammars"
+)
+
+func TestGenerateFormatter(t *testing.T) {
+	src, err := generateFormatter(grammars.Lookup("json"), "jsonfmt")
+	if err != nil {
+		t.Fatalf("generateFormatter(json) err = %s, want nil", err)
+	}
+
+	if _, err := parser.ParseFile(token.NewFileSet(), "formatter.go", src, 0); err != nil {
+		t.Errorf("generateFormatter(json) generated invalid Go: %s", err)
+	}
+
+	for _, want := range []string{
+		"package jsonfmt",
+		`"bramp.net/antlr4/json"`,
+		"case json.JSONParserRULE_json:",
+		"case json.JSONParserRULE_pair:",
+	} {
+		if !strings.Contains(string(src), want) {
+			t.Errorf("generateFormatter(json) = %q, want it to contain %q", src, want)
+		}
+	}
+}
+
+func TestGenerateFormatterLexerOnly(t *testing.T) {
+	g := &grammars.Grammar{Name: "lexeronly"}
+	if _, err := generateFormatter(g, "lexeronlyfmt"); err == nil {
+		t.Errorf("generateFormatter(lexeronly) err = nil, want error")
+	}
+}
@@ -0,0 +1,161 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+	"text/template"
+
+	"bramp.net/antlr4/grammars"
+	"bramp.net/antlr4/grammars/rewrite"
+	"bramp.net/antlr4/grammars/xpath"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+var grepCmd = newCommand("grep", "<xpath> <file, directory or archive>...", "search files for parse tree nodes matching a XPath, optionally replacing them")
+
+var (
+	grepGrammar = grepCmd.flags.String("grammar", "", "name of the grammar to parse with (default chosen by the extension of each archive member)")
+	grepInclude = grepCmd.flags.String("include", "", `only search files whose name matches this pattern, e.g "*.sql"`)
+	grepReplace = grepCmd.flags.String("replace", "", `template to replace each match with, e.g '{{ .Text | upper }}'`)
+	grepWrite   = grepCmd.flags.Bool("w", false, "with -replace, write the result to the file, in its original charset, instead of stdout")
+)
+
+func init() {
+	grepCmd.run = runGrep
+}
+
+// position returns the token where the node starts.
+func position(t antlr.ParseTree) antlr.Token {
+	switch n := t.(type) {
+	case antlr.ParserRuleContext:
+		return n.GetStart()
+	case antlr.TerminalNode:
+		return n.GetSymbol()
+	}
+	return nil
+}
+
+// firstLine returns s up to the first new line.
+func firstLine(s string) string {
+	if i := strings.IndexAny(s, "\r\n"); i >= 0 {
+		return s[:i]
+	}
+	return s
+}
+
+func runGrep(args []string) error {
+	g, err := lookupDefaultGrammar(*grepGrammar)
+	if err != nil {
+		return err
+	}
+	if len(args) < 2 {
+		return fmt.Errorf("expected a xpath and at least one file")
+	}
+
+	var tmpl *template.Template
+	if *grepReplace != "" {
+		if tmpl, err = rewrite.NewTemplate(*grepReplace); err != nil {
+			return err
+		}
+	} else if *grepWrite {
+		return fmt.Errorf("-w requires -replace")
+	}
+
+	path := args[0]
+	matches := 0
+	err = walkInputs(args[1:], func(in *input) error {
+		if ok, err := in.included(*grepInclude); err != nil || !ok {
+			return err
+		}
+
+		g, err := in.grammar(g)
+		if err != nil || g == nil {
+			return err
+		}
+		result, err := in.parse(g)
+		if err != nil {
+			return err
+		}
+		for _, e := range result.Errors {
+			fmt.Fprintln(os.Stderr, e)
+		}
+
+		nodes, err := xpath.FindAll(result.Tree, path, result.Parser)
+		if err != nil {
+			return err
+		}
+
+		matches += len(nodes)
+
+		if tmpl != nil {
+			return replace(in, result, nodes, tmpl)
+		}
+
+		for _, node := range nodes {
+			text := result.Tokens.GetTextFromInterval(node.GetSourceInterval())
+			if tok := position(node); tok != nil {
+				fmt.Printf("%s:%d:%d: %s\n", in.name, tok.GetLine(), tok.GetColumn()+1, firstLine(text))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// Like grep, exit with a non-zero status if nothing was found.
+	if matches == 0 {
+		os.Exit(1)
+	}
+	return nil
+}
+
+// replace rewrites the matched nodes with the template, and either prints the
+// new file, or if -w is set, writes it back to the file.
+func replace(in *input, result *grammars.Result, nodes []antlr.ParseTree, tmpl *template.Template) error {
+	r := rewrite.New(result.Tokens.GetTokenSource().GetInputStream(), result.Parser)
+	if err := r.ReplaceAll(nodes, tmpl); err != nil {
+		return fmt.Errorf("%s: %s", in.name, err)
+	}
+
+	if !*grepWrite {
+		_, err := fmt.Print(r.Text())
+		return err
+	}
+
+	if !r.Changed() {
+		return nil
+	}
+
+	if in.inArchive {
+		return fmt.Errorf("%s: can not write to a member of a archive", in.name)
+	}
+
+	// Write the file back in the charset it was read in.
+	data, err := in.encode(r.Text())
+	if err != nil {
+		return err
+	}
+
+	info, err := os.Stat(in.name)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(in.name, data, info.Mode())
+}
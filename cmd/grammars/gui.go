@@ -0,0 +1,219 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"os/exec"
+
+	"bramp.net/antlr4/grammars"
+	"bramp.net/antlr4/grammars/dot"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+var guiCmd = newCommand("gui", "<file>", "draw the parse tree as a SVG or DOT graph, or view it in a browser")
+
+var (
+	guiGrammar = guiCmd.flags.String("grammar", "", "name of the grammar to parse with")
+	guiFormat  = guiCmd.flags.String("format", "svg", `output format, "svg" (requires Graphviz's dot), "dot" or "html"`)
+	guiOutput  = guiCmd.flags.String("o", "", "file to write the output to (default stdout)")
+	guiHTTP    = guiCmd.flags.String("http", "", `serve the interactive HTML view on this address, e.g "localhost:8080"`)
+)
+
+func init() {
+	guiCmd.run = runGui
+}
+
+func runGui(args []string) error {
+	// Check the format before -o truncates the output file.
+	write, ok := guiWriters[*guiFormat]
+	if !ok {
+		return fmt.Errorf("unknown format %q", *guiFormat)
+	}
+
+	g, err := lookupGrammar(*guiGrammar)
+	if err != nil {
+		return err
+	}
+	if len(args) != 1 {
+		return fmt.Errorf("expected exactly one file")
+	}
+
+	filename := args[0]
+	result, err := g.ParseFile(filename)
+	if err != nil {
+		return err
+	}
+	for _, e := range result.Errors {
+		fmt.Fprintln(os.Stderr, e)
+	}
+
+	if *guiHTTP != "" {
+		return serveHTML(*guiHTTP, filename, result)
+	}
+
+	out := io.Writer(os.Stdout)
+	if *guiOutput != "" {
+		f, err := os.Create(*guiOutput)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		out = f
+	}
+
+	return write(out, filename, result)
+}
+
+// guiWriter writes the parse tree of the file in one of the gui formats.
+type guiWriter func(w io.Writer, filename string, result *grammars.Result) error
+
+// guiWriters are the gui formats.
+var guiWriters = map[string]guiWriter{
+	"dot": func(w io.Writer, _ string, result *grammars.Result) error {
+		return dot.Write(w, result.Tree, result.Parser)
+	},
+	"svg": func(w io.Writer, _ string, result *grammars.Result) error {
+		return writeSVG(w, result)
+	},
+	"html": writeHTML,
+}
+
+// writeSVG renders the tree with Graphviz's dot command.
+func writeSVG(w io.Writer, result *grammars.Result) error {
+	path, err := exec.LookPath("dot")
+	if err != nil {
+		return fmt.Errorf("the svg format requires Graphviz's dot to be installed, or use -format dot: %s", err)
+	}
+
+	var in bytes.Buffer
+	if err := dot.Write(&in, result.Tree, result.Parser); err != nil {
+		return err
+	}
+
+	cmd := exec.Command(path, "-Tsvg")
+	cmd.Stdin = &in
+	cmd.Stdout = w
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// serveHTML serves the interactive HTML view until the process is killed.
+func serveHTML(addr, filename string, result *grammars.Result) error {
+	var page bytes.Buffer
+	if err := writeHTML(&page, filename, result); err != nil {
+		return err
+	}
+
+	http.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Write(page.Bytes())
+	})
+
+	log.Printf("Serving the parse tree of %s on http://%s/", filename, addr)
+	return http.ListenAndServe(addr, nil)
+}
+
+// htmlNode is a node of the parse tree, as displayed in the HTML view.
+type htmlNode struct {
+	Label    string
+	Token    bool
+	Error    bool
+	Line     int
+	Column   int
+	Children []*htmlNode
+}
+
+func newHTMLNode(t antlr.Tree, recognizer antlr.Recognizer) *htmlNode {
+	n := &htmlNode{
+		Label: dot.Label(t, recognizer),
+	}
+
+	switch t.(type) {
+	case antlr.ErrorNode:
+		n.Token, n.Error = true, true
+	case antlr.TerminalNode:
+		n.Token = true
+	}
+
+	if tok := position(t.(antlr.ParseTree)); tok != nil {
+		n.Line, n.Column = tok.GetLine(), tok.GetColumn()+1
+	}
+
+	for _, c := range t.GetChildren() {
+		n.Children = append(n.Children, newHTMLNode(c, recognizer))
+	}
+	return n
+}
+
+var htmlTemplate = template.Must(template.New("page").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{ .Filename }} ({{ .Grammar }})</title>
+<style>
+body { font-family: Helvetica, sans-serif; }
+details { margin-left: 1.5em; }
+summary { cursor: pointer; }
+.token { margin-left: 1.5em; font-family: monospace; display: block; }
+.token:before { content: "\2022  "; }
+.error { color: red; }
+.pos { color: #999; font-size: small; }
+</style>
+</head>
+<body>
+<h1>{{ .Filename }} <small>({{ .Grammar }})</small></h1>
+<p>
+<button onclick="toggle(true)">Expand all</button>
+<button onclick="toggle(false)">Collapse all</button>
+</p>
+{{ template "node" .Root }}
+<script>
+function toggle(open) {
+	document.querySelectorAll("details").forEach(function(d) { d.open = open; });
+}
+</script>
+</body>
+</html>
+{{ define "node" -}}
+{{ if .Token -}}
+<span class="token{{ if .Error }} error{{ end }}" title="{{ .Line }}:{{ .Column }}">{{ .Label }}</span>
+{{- else -}}
+<details open><summary>{{ .Label }} <span class="pos">{{ .Line }}:{{ .Column }}</span></summary>
+{{ range .Children }}{{ template "node" . }}{{ end }}
+</details>
+{{- end }}
+{{- end }}
+`))
+
+// writeHTML writes the tree as a standalone HTML page, where each rule can be
+// expanded or collapsed.
+func writeHTML(w io.Writer, filename string, result *grammars.Result) error {
+	return htmlTemplate.Execute(w, struct {
+		Filename string
+		Grammar  string
+		Root     *htmlNode
+	}{
+		Filename: filename,
+		Grammar:  result.Grammar.Name,
+		Root:     newHTMLNode(result.Tree, result.Parser),
+	})
+}
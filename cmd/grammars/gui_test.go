@@ -0,0 +1,46 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestRunGuiFormat(t *testing.T) {
+	defer func(format, output string) { *guiFormat, *guiOutput = format, output }(*guiFormat, *guiOutput)
+
+	dir, err := ioutil.TempDir("", "gui")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	output := filepath.Join(dir, "tree.svg")
+	if err := ioutil.WriteFile(output, []byte("keep"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// Fails before the output file is truncated.
+	*guiFormat, *guiOutput = "xml", output
+	if err := runGui([]string{"example.json"}); err == nil {
+		t.Errorf("runGui(-format %s) err = nil, want unknown format", *guiFormat)
+	}
+	if data, err := ioutil.ReadFile(output); err != nil || string(data) != "keep" {
+		t.Errorf("runGui(-format %s) changed -o %s to %q, %v, want it unchanged", *guiFormat, output, data, err)
+	}
+}
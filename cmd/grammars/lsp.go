@@ -0,0 +1,39 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+
+	"bramp.net/antlr4/grammars/lsp"
+)
+
+var lspCmd = newCommand("lsp", "", "run a Language Server on stdin and stdout, reporting syntax errors as diagnostics")
+
+func init() {
+	lspCmd.run = runLSP
+}
+
+func runLSP(args []string) error {
+	if len(args) != 0 {
+		return fmt.Errorf("unexpected arguments %q", args)
+	}
+
+	s := lsp.NewServer(os.Stdin, os.Stdout)
+	s.Logger = log.New(os.Stderr, "", log.LstdFlags)
+	return s.Serve()
+}
@@ -0,0 +1,187 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// grammars is a command line tool for parsing and searching files with any of
+// the pre-compiled grammars.
+//
+// Usage:
+//
+//	grammars <command> [flags] [arguments]
+//
+// Run "grammars" with no arguments to see the list of commands.
+package main // import "bramp.net/antlr4/cmd/grammars"
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"bramp.net/antlr4/grammars"
+	_ "bramp.net/antlr4/grammars/all"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// command is one of the sub commands of the tool.
+type command struct {
+	name  string
+	args  string // Arguments shown in the usage
+	short string // One line description
+
+	flags *flag.FlagSet
+	run   func(args []string) error
+}
+
+func (c *command) usage() {
+	fmt.Fprintf(os.Stderr, "Usage: %s %s [flags] %s\n\n%s\n\nFlags:\n", filepath.Base(os.Args[0]), c.name, c.args, c.short)
+	c.flags.PrintDefaults()
+}
+
+func newCommand(name, args, short string) *command {
+	c := &command{
+		name:  name,
+		args:  args,
+		short: short,
+		flags: flag.NewFlagSet(name, flag.ExitOnError),
+	}
+	c.flags.Usage = c.usage
+	return c
+}
+
+// commands is the list of all commands, in the order they are shown.
+var commands = []*command{
+	parseCmd,
+	grepCmd,
+	tokensCmd,
+	guiCmd,
+	statsCmd,
+	benchCmd,
+	corpusCmd,
+	lspCmd,
+	doctorCmd,
+	formatterCmd,
+	compatCmd,
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: %s <command> [flags] [arguments]\n\nCommands:\n", filepath.Base(os.Args[0]))
+	for _, c := range commands {
+		fmt.Fprintf(os.Stderr, "  %-10s %s\n", c.name, c.short)
+	}
+	os.Exit(2)
+}
+
+// lookupGrammar returns the named grammar, or an error listing the valid names.
+func lookupGrammar(name string) (*grammars.Grammar, error) {
+	if name == "" {
+		return nil, fmt.Errorf("the -grammar flag is required")
+	}
+	g := grammars.Lookup(name)
+	if g == nil {
+		if d := grammars.LookupDisabled(name); d != nil {
+			return nil, fmt.Errorf("grammar %q is disabled: %s", name, d.Reason)
+		}
+		return nil, fmt.Errorf("unknown grammar %q", name)
+	}
+	return g, nil
+}
+
+// lookupDefaultGrammar is like lookupGrammar, but returns nil if no name was
+// given, for commands that can choose the grammar of each archive member.
+func lookupDefaultGrammar(name string) (*grammars.Grammar, error) {
+	if name == "" {
+		return nil, nil
+	}
+	return lookupGrammar(name)
+}
+
+// input is a file, or a member of a archive, to be parsed.
+type input struct {
+	name      string // File name, or "archive!member" for a member of a archive
+	inArchive bool
+	data      []byte
+	charset   grammars.Charset // Set by stream
+}
+
+// stream returns the input's text, transcoded to UTF-8 if it is in another
+// charset, such as UTF-16.
+func (in *input) stream() antlr.CharStream {
+	text, charset := grammars.DecodeCharset(in.data)
+	in.charset = charset
+	return grammars.NewNamedStream(antlr.NewInputStream(text), in.name, "")
+}
+
+// encode returns the text transcoded back to the input's charset, with the
+// same byte order mark, if it had one.
+func (in *input) encode(text string) ([]byte, error) {
+	data, err := grammars.EncodeCharset(text, in.charset)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", in.name, err)
+	}
+	return append(append([]byte(nil), grammars.ByteOrderMark(in.data)...), data...), nil
+}
+
+// parse parses the input with the grammar.
+func (in *input) parse(g *grammars.Grammar, opts ...grammars.Option) (*grammars.Result, error) {
+	return g.Parse(in.stream(), opts...)
+}
+
+// walkInputs calls fn for each file named in args, recursing into directories
+// and archives.
+func walkInputs(args []string, fn func(in *input) error) error {
+	for _, arg := range args {
+		err := filepath.Walk(arg, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				return err
+			}
+			if info.IsDir() {
+				return nil
+			}
+
+			data, err := ioutil.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			return walkInput(&input{name: path, data: data}, fn)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+	}
+
+	name := os.Args[1]
+	for _, c := range commands {
+		if c.name != name {
+			continue
+		}
+
+		c.flags.Parse(os.Args[2:])
+		if err := c.run(c.flags.Args()); err != nil {
+			fmt.Fprintf(os.Stderr, "%s: %s\n", c.name, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	fmt.Fprintf(os.Stderr, "Unknown command %q\n\n", name)
+	usage()
+}
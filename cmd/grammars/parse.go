@@ -0,0 +1,110 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"bramp.net/antlr4/grammars"
+	"bramp.net/antlr4/grammars/treemap"
+)
+
+var parseCmd = newCommand("parse", "<file, directory or archive>...", "parse files and print their parse trees")
+
+var (
+	parseGrammar = parseCmd.flags.String("grammar", "", "name of the grammar to parse with (default chosen by the extension of each archive member)")
+	parseInclude = parseCmd.flags.String("include", "", `only parse files whose name matches this pattern, e.g "*.sql"`)
+	parseNoColor = parseCmd.flags.Bool("no-color", false, "disable colored output (the default when not writing to a terminal)")
+	parseWidth   = parseCmd.flags.Int("width", 0, "truncate token text to fit this many columns, 0 for $COLUMNS (or 80 if it isn't exported) when writing to a terminal, or -1 for no limit")
+	parseLisp    = parseCmd.flags.Bool("lisp", false, "print each tree as a single LISP-style line, as the Java TestRig does")
+	parseJSON    = parseCmd.flags.Bool("json", false, "print each tree as JSON, with the token names and positions, e.g for jq")
+	parseTrace   = parseCmd.flags.Bool("trace", false, "print each rule entered and exited, and token consumed, to stderr while parsing")
+)
+
+func init() {
+	parseCmd.run = runParse
+}
+
+func runParse(args []string) error {
+	g, err := lookupDefaultGrammar(*parseGrammar)
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return fmt.Errorf("no files given")
+	}
+
+	terminal := isTerminal(os.Stdout)
+	printer := &treePrinter{
+		color:  terminal && !*parseNoColor && os.Getenv("NO_COLOR") == "",
+		width:  *parseWidth,
+		indent: "  ",
+	}
+	if printer.width == 0 && terminal {
+		printer.width = terminalWidth()
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+
+	var opts []grammars.Option
+	if *parseTrace {
+		opts = append(opts, grammars.WithTrace(os.Stderr))
+	}
+
+	errors := 0
+	err = walkInputs(args, func(in *input) error {
+		if ok, err := in.included(*parseInclude); err != nil || !ok {
+			return err
+		}
+
+		g, err := in.grammar(g)
+		if err != nil || g == nil {
+			return err
+		}
+		result, err := in.parse(g, opts...)
+		if err != nil {
+			return err
+		}
+
+		for _, e := range result.Errors {
+			fmt.Fprintln(os.Stderr, e)
+		}
+		errors += len(result.Errors)
+
+		if *parseLisp {
+			fmt.Println(result.Tree.ToStringTree(nil, result.Parser))
+			return nil
+		}
+
+		if *parseJSON {
+			c := &treemap.Converter{Recognizer: result.Parser, Tokens: true}
+			return encoder.Encode(c.Convert(result.Tree))
+		}
+
+		printer.recognizer = result.Parser
+		return printer.Print(os.Stdout, result.Tree)
+	})
+	if err != nil {
+		return err
+	}
+
+	if errors > 0 {
+		return fmt.Errorf("found %d syntax errors", errors)
+	}
+	return nil
+}
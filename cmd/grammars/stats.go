@@ -0,0 +1,121 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"go/build"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"bramp.net/antlr4/grammars"
+)
+
+var statsCmd = newCommand("stats", "[grammar]...", "print the size of each grammar, to help understand the cost of using it")
+
+var (
+	statsSort = statsCmd.flags.String("sort", "name", `column to sort by, "name", "rules", "tokens", "states", "decisions" or "code"`)
+)
+
+func init() {
+	statsCmd.run = runStats
+}
+
+// codeSize returns the total size of the grammar's non-test Go source files,
+// or -1 if the source can not be found.
+func codeSize(g *grammars.Grammar) int64 {
+	pkg, err := build.Import(g.ImportPath(), "", 0)
+	if err != nil {
+		return -1
+	}
+
+	var size int64
+	for _, name := range pkg.GoFiles {
+		info, err := os.Stat(filepath.Join(pkg.Dir, name))
+		if err != nil {
+			return -1
+		}
+		size += info.Size()
+	}
+	return size
+}
+
+type grammarStats struct {
+	*grammars.Grammar
+	*grammars.Stats
+	code int64
+}
+
+func runStats(args []string) error {
+	var gs []*grammars.Grammar
+	if len(args) == 0 {
+		gs = grammars.All()
+	}
+	for _, name := range args {
+		g, err := lookupGrammar(name)
+		if err != nil {
+			return err
+		}
+		gs = append(gs, g)
+	}
+
+	var stats []grammarStats
+	for _, g := range gs {
+		stats = append(stats, grammarStats{g, g.Stats(), codeSize(g)})
+	}
+
+	var key func(s grammarStats) int64
+	switch *statsSort {
+	case "name":
+	case "rules":
+		key = func(s grammarStats) int64 { return int64(s.LexerRules + s.ParserRules) }
+	case "tokens":
+		key = func(s grammarStats) int64 { return int64(s.Tokens) }
+	case "states":
+		key = func(s grammarStats) int64 { return int64(s.LexerStates + s.ParserStates) }
+	case "decisions":
+		key = func(s grammarStats) int64 { return int64(s.LexerDecisions + s.ParserDecisions) }
+	case "code":
+		key = func(s grammarStats) int64 { return s.code }
+	default:
+		return fmt.Errorf("unknown sort column %q", *statsSort)
+	}
+	if key != nil {
+		// Largest first
+		sort.SliceStable(stats, func(i, j int) bool {
+			return key(stats[i]) > key(stats[j])
+		})
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, strings.Join([]string{
+		"Name", "Lexer Rules", "Parser Rules", "Tokens", "Modes",
+		"Lexer States", "Lexer Decisions", "Parser States", "Parser Decisions", "Code (KiB)", "",
+	}, "\t"))
+
+	for _, s := range stats {
+		code := "?"
+		if s.code >= 0 {
+			code = fmt.Sprintf("%d", s.code/1024)
+		}
+		fmt.Fprintf(w, "%s\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%d\t%s\t\n",
+			s.Name, s.LexerRules, s.ParserRules, s.Tokens, s.LexerModes,
+			s.LexerStates, s.LexerDecisions, s.ParserStates, s.ParserDecisions, code)
+	}
+	return w.Flush()
+}
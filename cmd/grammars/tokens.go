@@ -0,0 +1,126 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"bramp.net/antlr4/grammars"
+	"bramp.net/antlr4/grammars/interp"
+	"bramp.net/antlr4/grammars/tokenclass"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+var tokensCmd = newCommand("tokens", "<file, directory or archive>...", "print the tokens of files, with their generic class and TextMate style scope")
+
+var (
+	tokensGrammar = tokensCmd.flags.String("grammar", "", "name of the grammar to lex with (default chosen by the extension of each archive member)")
+	tokensInclude = tokensCmd.flags.String("include", "", `only lex files whose name matches this pattern, e.g "*.sql"`)
+	tokensJSON    = tokensCmd.flags.Bool("json", false, "print each token as a line of JSON, for consumption by other tools")
+	tokensG4      = tokensCmd.flags.String("g4", "", "comma separated .g4 files to interpret the lexer from, instead of a pre-compiled grammar (requires java and the ANTLR jar)")
+)
+
+func init() {
+	tokensCmd.run = runTokens
+}
+
+// jsonToken is the JSON output of a single token.
+type jsonToken struct {
+	File    string `json:"file"`
+	Line    int    `json:"line"`   // Starting at 1
+	Column  int    `json:"column"` // Starting at 1, in runes
+	Start   int    `json:"start"`  // Rune offset of the first character
+	Stop    int    `json:"stop"`   // Rune offset of the last character
+	Type    string `json:"type"`
+	Channel int    `json:"channel"`
+	Class   string `json:"class"`
+	Scope   string `json:"scope,omitempty"`
+	Text    string `json:"text"`
+}
+
+func runTokens(args []string) error {
+	var g *grammars.Grammar
+	var err error
+	if *tokensG4 != "" {
+		g, err = interp.Load(strings.Split(*tokensG4, ",")...)
+	} else {
+		g, err = lookupDefaultGrammar(*tokensGrammar)
+	}
+	if err != nil {
+		return err
+	}
+	if len(args) == 0 {
+		return fmt.Errorf("no files given")
+	}
+
+	classifiers := make(map[*grammars.Grammar]*tokenclass.Classifier)
+
+	out := bufio.NewWriter(os.Stdout)
+	defer out.Flush()
+	enc := json.NewEncoder(out)
+
+	return walkInputs(args, func(in *input) error {
+		if ok, err := in.included(*tokensInclude); err != nil || !ok {
+			return err
+		}
+
+		g := g
+		if *tokensG4 == "" {
+			// Interpreted grammars aren't registered, so can't be chosen by extension.
+			var err error
+			if g, err = in.grammar(g); err != nil || g == nil {
+				return err
+			}
+		}
+		classifier, found := classifiers[g]
+		if !found {
+			classifier = tokenclass.New(g)
+			classifiers[g] = classifier
+		}
+
+		// Lex with Tokenize, which holds the grammar's locks, and only use
+		// this lexer for the token names.
+		lexer := g.NewLexer(antlr.NewInputStream(""))
+		toks, _ := g.Tokenize(in.stream())
+
+		for _, tok := range toks {
+			t := jsonToken{
+				File:    in.name,
+				Line:    tok.GetLine(),
+				Column:  tok.GetColumn() + 1,
+				Start:   tok.GetStart(),
+				Stop:    tok.GetStop(),
+				Type:    tokenName(lexer, tok),
+				Channel: tok.GetChannel(),
+				Class:   classifier.Class(tok.GetTokenType()).String(),
+				Scope:   classifier.Scope(tok.GetTokenType()),
+				Text:    tok.GetText(),
+			}
+
+			if *tokensJSON {
+				if err := enc.Encode(t); err != nil {
+					return err
+				}
+				continue
+			}
+			fmt.Fprintf(out, "%s:%d:%d: %s %s %s %q\n", t.File, t.Line, t.Column, t.Type, t.Class, t.Scope, t.Text)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,150 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// ANSI escape codes used by treePrinter.
+const (
+	colorReset = "\033[0m"
+	colorRule  = "\033[1;36m" // Bold cyan
+	colorToken = "\033[33m"   // Yellow
+	colorText  = "\033[32m"   // Green
+	colorError = "\033[1;31m" // Bold red
+)
+
+// treePrinter prints a parse tree with one node per line, indented by depth.
+type treePrinter struct {
+	recognizer antlr.Recognizer
+	color      bool
+	width      int // Maximum width of each line, or zero for no limit
+	indent     string
+}
+
+// isTerminal returns true if f is a terminal (and not a file or pipe).
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// terminalWidth returns the width of the terminal as reported by the COLUMNS
+// environment variable, or 80 if it isn't set. Shells set COLUMNS, but don't
+// usually export it, so it must be exported to take effect, e.g
+// "COLUMNS=$COLUMNS grammars parse ...". Querying the terminal's size
+// directly needs a system call for each platform.
+func terminalWidth() int {
+	if n, err := strconv.Atoi(os.Getenv("COLUMNS")); err == nil && n > 0 {
+		return n
+	}
+	return 80
+}
+
+func (p *treePrinter) Print(w io.Writer, t antlr.Tree) error {
+	out := bufio.NewWriter(w)
+	p.print(out, t, 0)
+	return out.Flush()
+}
+
+func (p *treePrinter) print(w io.Writer, t antlr.Tree, depth int) {
+	indent := strings.Repeat(p.indent, depth)
+
+	switch n := t.(type) {
+	case antlr.RuleContext:
+		name := ruleName(p.recognizer, n)
+		fmt.Fprintf(w, "%s%s\n", indent, p.paint(colorRule, name))
+
+	case antlr.ErrorNode:
+		text := p.truncate(escape(n.GetText()), len(indent)+len("error "))
+		fmt.Fprintf(w, "%s%s %s\n", indent, p.paint(colorError, "error"), p.paint(colorError, text))
+
+	case antlr.TerminalNode:
+		name, text := tokenName(p.recognizer, n.GetSymbol()), escape(n.GetText())
+		if name == "'"+text+"'" {
+			// Literal tokens, such as '{', don't need their text repeated.
+			fmt.Fprintf(w, "%s%s\n", indent, p.paint(colorToken, name))
+			break
+		}
+		text = p.truncate(text, len(indent)+utf8.RuneCountInString(name)+1)
+		fmt.Fprintf(w, "%s%s %s\n", indent, p.paint(colorToken, name), p.paint(colorText, text))
+	}
+
+	for _, c := range t.GetChildren() {
+		p.print(w, c, depth+1)
+	}
+}
+
+// paint wraps s in the color, if colors are enabled.
+func (p *treePrinter) paint(color, s string) string {
+	if !p.color {
+		return s
+	}
+	return color + s + colorReset
+}
+
+// truncate shortens s so it fits in the remaining width, after used columns.
+func (p *treePrinter) truncate(s string, used int) string {
+	const ellipsis = "…"
+	if p.width <= 0 {
+		return s
+	}
+
+	max := p.width - used
+	if max < 1 {
+		max = 1
+	}
+	if utf8.RuneCountInString(s) <= max {
+		return s
+	}
+	runes := []rune(s)
+	return string(runes[:max-1]) + ellipsis
+}
+
+// escape replaces the control characters in s, so the text fits on one line.
+func escape(s string) string {
+	return strings.NewReplacer("\n", `\n`, "\r", `\r`, "\t", `\t`).Replace(s)
+}
+
+func ruleName(recognizer antlr.Recognizer, n antlr.RuleContext) string {
+	names := recognizer.GetRuleNames()
+	if i := n.GetRuleIndex(); i >= 0 && i < len(names) {
+		return names[i]
+	}
+	return fmt.Sprintf("rule%d", n.GetRuleIndex())
+}
+
+// tokenName returns the symbolic name of the token, or failing that its literal name.
+func tokenName(recognizer antlr.Recognizer, tok antlr.Token) string {
+	ttype := tok.GetTokenType()
+	if ttype == antlr.TokenEOF {
+		return "EOF"
+	}
+	if names := recognizer.GetSymbolicNames(); ttype >= 0 && ttype < len(names) && names[ttype] != "" {
+		return names[ttype]
+	}
+	if names := recognizer.GetLiteralNames(); ttype >= 0 && ttype < len(names) && names[ttype] != "" {
+		return names[ttype]
+	}
+	return strconv.Itoa(ttype)
+}
@@ -0,0 +1,117 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"bramp.net/antlr4/json"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+func TestTreePrinter(t *testing.T) {
+	const input = "{\"a\": \"a long\\tstring\", \"b\": [true]}"
+
+	tests := []struct {
+		width int
+		want  string
+	}{
+		{
+			width: 0,
+			want: `json
+. value
+. . obj
+. . . '{'
+. . . pair
+. . . . STRING "a"
+. . . . ':'
+. . . . value
+. . . . . STRING "a long\tstring"
+. . . ','
+. . . pair
+. . . . STRING "b"
+. . . . ':'
+. . . . value
+. . . . . array
+. . . . . . '['
+. . . . . . value
+. . . . . . . 'true'
+. . . . . . ']'
+. . . '}'
+`,
+		}, {
+			width: 20,
+			want: `json
+. value
+. . obj
+. . . '{'
+. . . pair
+. . . . STRING "a"
+. . . . ':'
+. . . . value
+. . . . . STRING "a…
+. . . ','
+. . . pair
+. . . . STRING "b"
+. . . . ':'
+. . . . value
+. . . . . array
+. . . . . . '['
+. . . . . . value
+. . . . . . . 'true'
+. . . . . . ']'
+. . . '}'
+`,
+		},
+	}
+
+	for _, test := range tests {
+		p := json.NewJSONParser(antlr.NewCommonTokenStream(json.NewJSONLexer(antlr.NewInputStream(input)), antlr.TokenDefaultChannel))
+		tree := p.Json()
+
+		printer := &treePrinter{
+			recognizer: p,
+			width:      test.width,
+			indent:     ". ",
+		}
+
+		var buf bytes.Buffer
+		if err := printer.Print(&buf, tree); err != nil {
+			t.Errorf("Print(width: %d) err = %s, want nil", test.width, err)
+			continue
+		}
+		if got := buf.String(); got != test.want {
+			t.Errorf("Print(width: %d) = \n%s\nwant:\n%s", test.width, got, test.want)
+		}
+	}
+}
+
+func TestTreePrinterColor(t *testing.T) {
+	p := json.NewJSONParser(antlr.NewCommonTokenStream(json.NewJSONLexer(antlr.NewInputStream("1")), antlr.TokenDefaultChannel))
+	tree := p.Json()
+
+	printer := &treePrinter{recognizer: p, color: true, indent: " "}
+
+	var buf bytes.Buffer
+	printer.Print(&buf, tree)
+
+	want := colorRule + "json" + colorReset + "\n" +
+		" " + colorRule + "value" + colorReset + "\n" +
+		"  " + colorToken + "NUMBER" + colorReset + " " + colorText + "1" + colorReset + "\n"
+	if got := buf.String(); got != want {
+		t.Errorf("Print(color: true) = %q, want %q", got, want)
+	}
+}
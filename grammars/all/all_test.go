@@ -0,0 +1,56 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package all_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"bramp.net/antlr4/grammars"
+	_ "bramp.net/antlr4/grammars/all"
+)
+
+// TestIndex checks grammars.json is in sync with the registered grammars. It
+// is skipped unless make has written grammars.json, as it isn't committed.
+func TestIndex(t *testing.T) {
+	idx, err := grammars.ReadIndexFile(filepath.Join("..", "..", grammars.IndexFilename))
+	if os.IsNotExist(err) {
+		t.Skipf("%s not found, run make to create it", grammars.IndexFilename)
+	}
+	if err != nil {
+		t.Fatalf("ReadIndexFile(...) err = %s, want nil", err)
+	}
+
+	registered := make(map[string]bool)
+	for _, g := range grammars.All() {
+		registered[g.Name] = true
+
+		e := idx.Lookup(g.Name)
+		if e == nil || !e.Passed {
+			t.Errorf("%s is registered, but not listed as passing in the index", g.Name)
+			continue
+		}
+		if g.HasParser() && e.EntryPoint != g.EntryPoint {
+			t.Errorf("%s index EntryPoint = %q, want %q", g.Name, e.EntryPoint, g.EntryPoint)
+		}
+	}
+
+	for _, e := range idx.Grammars {
+		if e.Passed && !registered[e.Name] {
+			t.Errorf("%s is listed as passing in the index, but is not registered", e.Name)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grammars
+
+import "github.com/antlr/antlr4/runtime/Go/antlr"
+
+// FilterChannels returns the tokens on any of the given channels.
+func FilterChannels(tokens []antlr.Token, channels ...int) []antlr.Token {
+	var filtered []antlr.Token
+	for _, t := range tokens {
+		if onChannel(t, channels) {
+			filtered = append(filtered, t)
+		}
+	}
+	return filtered
+}
+
+// VisibleTokens returns the tokens on the default channel, which are the
+// ones seen by the parser.
+func VisibleTokens(tokens []antlr.Token) []antlr.Token {
+	return FilterChannels(tokens, antlr.TokenDefaultChannel)
+}
+
+// HiddenTokens returns the tokens not on the default channel, such as
+// whitespace or comments, depending on the grammar. To find just the comments
+// use tokenclass.Classifier.Filter.
+func HiddenTokens(tokens []antlr.Token) []antlr.Token {
+	var filtered []antlr.Token
+	for _, t := range tokens {
+		if t.GetChannel() != antlr.TokenDefaultChannel {
+			filtered = append(filtered, t)
+		}
+	}
+	return filtered
+}
+
+// channelFilter is a Lexer that drops the tokens not on its channels.
+type channelFilter struct {
+	antlr.Lexer
+
+	channels []int
+}
+
+// NewChannelFilter returns a Lexer which only emits the tokens on the given
+// channels (and EOF), for example to remove comments from a token stream
+// entirely, instead of just hiding them from the parser:
+//
+//	tokens := antlr.NewCommonTokenStream(grammars.NewChannelFilter(lexer, antlr.TokenDefaultChannel), antlr.TokenDefaultChannel)
+func NewChannelFilter(lexer antlr.Lexer, channels ...int) antlr.Lexer {
+	return &channelFilter{
+		Lexer:    lexer,
+		channels: channels,
+	}
+}
+
+func (f *channelFilter) NextToken() antlr.Token {
+	for {
+		t := f.Lexer.NextToken()
+		if t.GetTokenType() == antlr.TokenEOF || onChannel(t, f.channels) {
+			return t
+		}
+	}
+}
+
+func onChannel(t antlr.Token, channels []int) bool {
+	for _, c := range channels {
+		if t.GetChannel() == c {
+			return true
+		}
+	}
+	return false
+}
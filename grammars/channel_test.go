@@ -0,0 +1,89 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grammars_test
+
+import (
+	"testing"
+
+	"bramp.net/antlr4/grammars"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+func newToken(channel int) antlr.Token {
+	return antlr.NewCommonToken(&antlr.TokenSourceCharStreamPair{}, 1, channel, 0, 0)
+}
+
+func equalTokens(a, b []antlr.Token) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestFilterChannels(t *testing.T) {
+	visible1, hidden, other, visible2 := newToken(0), newToken(1), newToken(2), newToken(0)
+	tokens := []antlr.Token{visible1, hidden, other, visible2}
+
+	if got, want := grammars.VisibleTokens(tokens), []antlr.Token{visible1, visible2}; !equalTokens(got, want) {
+		t.Errorf("VisibleTokens(...) = %v, want %v", got, want)
+	}
+	if got, want := grammars.HiddenTokens(tokens), []antlr.Token{hidden, other}; !equalTokens(got, want) {
+		t.Errorf("HiddenTokens(...) = %v, want %v", got, want)
+	}
+	if got, want := grammars.FilterChannels(tokens, 2), []antlr.Token{other}; !equalTokens(got, want) {
+		t.Errorf("FilterChannels(..., 2) = %v, want %v", got, want)
+	}
+}
+
+func TestChannelFilter(t *testing.T) {
+	g := grammars.Lookup("json")
+
+	tests := []struct {
+		channel int
+		want    []string
+	}{
+		{antlr.TokenDefaultChannel, []string{"{", "}", "<EOF>"}},
+		{antlr.TokenHiddenChannel, []string{"<EOF>"}},
+	}
+
+	for _, test := range tests {
+		lexer := grammars.NewChannelFilter(g.NewLexer(antlr.NewInputStream("{}")), test.channel)
+
+		var got []string
+		for {
+			tok := lexer.NextToken()
+			got = append(got, tok.GetText())
+			if tok.GetTokenType() == antlr.TokenEOF {
+				break
+			}
+		}
+
+		if len(got) != len(test.want) {
+			t.Errorf("NewChannelFilter(..., %d) = %q, want %q", test.channel, got, test.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != test.want[i] {
+				t.Errorf("NewChannelFilter(..., %d) = %q, want %q", test.channel, got, test.want)
+				break
+			}
+		}
+	}
+}
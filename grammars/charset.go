@@ -0,0 +1,191 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grammars
+
+import (
+	"fmt"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+// Charset is a character encoding recognised by DetectCharset.
+type Charset int
+
+const (
+	UTF8 Charset = iota
+	UTF16LE
+	UTF16BE
+	Windows1252 // Also decodes ISO-8859-1, which it is a superset of
+)
+
+func (c Charset) String() string {
+	switch c {
+	case UTF8:
+		return "UTF-8"
+	case UTF16LE:
+		return "UTF-16LE"
+	case UTF16BE:
+		return "UTF-16BE"
+	case Windows1252:
+		return "windows-1252"
+	}
+	return "unknown"
+}
+
+// sniffSize is how much of the input DetectCharset checks for UTF-16.
+const sniffSize = 1024
+
+// DetectCharset guesses the encoding of the data, from its byte order mark,
+// or failing that, if it looks like UTF-16 (mostly ASCII, with every other byte
+// zero). Otherwise data that isn't valid UTF-8 is assumed to be in the legacy
+// Windows-1252 encoding, common in older SQL and COBOL files.
+func DetectCharset(data []byte) Charset {
+	switch {
+	case len(data) >= 3 && data[0] == 0xEF && data[1] == 0xBB && data[2] == 0xBF:
+		return UTF8
+	case len(data) >= 2 && data[0] == 0xFF && data[1] == 0xFE:
+		return UTF16LE
+	case len(data) >= 2 && data[0] == 0xFE && data[1] == 0xFF:
+		return UTF16BE
+	}
+
+	sample := data
+	if len(sample) > sniffSize {
+		sample = sample[:sniffSize]
+	}
+	if pairs := len(sample) / 2; pairs > 0 {
+		var even, odd int // Number of zero bytes at even and odd offsets
+		for i := 0; i+1 < len(sample); i += 2 {
+			if sample[i] == 0 {
+				even++
+			}
+			if sample[i+1] == 0 {
+				odd++
+			}
+		}
+		switch {
+		case odd*2 >= pairs && even*10 < pairs:
+			return UTF16LE
+		case even*2 >= pairs && odd*10 < pairs:
+			return UTF16BE
+		}
+	}
+
+	if !utf8.Valid(data) {
+		return Windows1252
+	}
+	return UTF8
+}
+
+// ByteOrderMark returns the byte order mark at the start of the data, or nil
+// if there is none.
+func ByteOrderMark(data []byte) []byte {
+	switch {
+	case len(data) >= 3 && data[0] == 0xEF && data[1] == 0xBB && data[2] == 0xBF:
+		return data[:3]
+	case len(data) >= 2 && (data[0] == 0xFF && data[1] == 0xFE || data[0] == 0xFE && data[1] == 0xFF):
+		return data[:2]
+	}
+	return nil
+}
+
+// DecodeCharset returns the data transcoded from its detected charset to
+// UTF-8, without any byte order mark, ready to be lexed.
+func DecodeCharset(data []byte) (string, Charset) {
+	charset := DetectCharset(data)
+	data = data[len(ByteOrderMark(data)):]
+
+	switch charset {
+	case UTF16LE, UTF16BE:
+		units := make([]uint16, len(data)/2)
+		for i := range units {
+			if charset == UTF16LE {
+				units[i] = uint16(data[2*i]) | uint16(data[2*i+1])<<8
+			} else {
+				units[i] = uint16(data[2*i])<<8 | uint16(data[2*i+1])
+			}
+		}
+		return string(utf16.Decode(units)), charset
+
+	case Windows1252:
+		runes := make([]rune, len(data))
+		for i, b := range data {
+			runes[i] = rune(b)
+			if b >= 0x80 && b < 0xA0 {
+				runes[i] = windows1252[b-0x80]
+			}
+		}
+		return string(runes), charset
+	}
+	return string(data), charset
+}
+
+// EncodeCharset returns the text transcoded from UTF-8 to the charset, without
+// any byte order mark, reversing DecodeCharset. It returns an error if the text
+// has characters the charset can't represent.
+func EncodeCharset(text string, charset Charset) ([]byte, error) {
+	switch charset {
+	case UTF8:
+		return []byte(text), nil
+
+	case UTF16LE, UTF16BE:
+		units := utf16.Encode([]rune(text))
+		data := make([]byte, 2*len(units))
+		for i, u := range units {
+			if charset == UTF16LE {
+				data[2*i], data[2*i+1] = byte(u), byte(u>>8)
+			} else {
+				data[2*i], data[2*i+1] = byte(u>>8), byte(u)
+			}
+		}
+		return data, nil
+
+	case Windows1252:
+		data := make([]byte, 0, len(text))
+		for _, r := range text {
+			b, ok := encodeWindows1252(r)
+			if !ok {
+				return nil, fmt.Errorf("%q can not be encoded in %s", r, charset)
+			}
+			data = append(data, b)
+		}
+		return data, nil
+	}
+	return nil, fmt.Errorf("unknown charset %s", charset)
+}
+
+// encodeWindows1252 returns the Windows-1252 byte for the rune, or false if
+// there is none.
+func encodeWindows1252(r rune) (byte, bool) {
+	for i, w := range windows1252 {
+		if w == r {
+			return byte(0x80 + i), true
+		}
+	}
+	if r < 0x100 && (r < 0x80 || r >= 0xA0) {
+		return byte(r), true
+	}
+	return 0, false
+}
+
+// windows1252 maps the bytes 0x80 to 0x9F, where Windows-1252 differs from
+// ISO-8859-1. The five undefined bytes map to the same control character as
+// ISO-8859-1.
+var windows1252 = [32]rune{
+	0x20AC, 0x0081, 0x201A, 0x0192, 0x201E, 0x2026, 0x2020, 0x2021,
+	0x02C6, 0x2030, 0x0160, 0x2039, 0x0152, 0x008D, 0x017D, 0x008F,
+	0x0090, 0x2018, 0x2019, 0x201C, 0x201D, 0x2022, 0x2013, 0x2014,
+	0x02DC, 0x2122, 0x0161, 0x203A, 0x0153, 0x009D, 0x017E, 0x0178,
+}
@@ -0,0 +1,71 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grammars_test
+
+import (
+	"testing"
+
+	"bramp.net/antlr4/grammars"
+)
+
+func TestDecodeCharset(t *testing.T) {
+	tests := []struct {
+		data    []byte
+		want    string
+		charset grammars.Charset
+	}{
+		{[]byte(`{"a": 1}`), `{"a": 1}`, grammars.UTF8},
+		{[]byte("\xEF\xBB\xBF{\"é\": 1}"), `{"é": 1}`, grammars.UTF8},
+		{[]byte("\xFF\xFE{\x00}\x00"), "{}", grammars.UTF16LE},
+		{[]byte("\xFE\xFF\x00{\x00}"), "{}", grammars.UTF16BE},
+		{[]byte("{\x00\"\x00a\x00\"\x00}\x00"), `{"a"}`, grammars.UTF16LE}, // No byte order mark
+		{[]byte("\x00{\x00\"\x00a\x00\"\x00}"), `{"a"}`, grammars.UTF16BE},
+		{[]byte("\xFE\xFF\xD8\x3D\xDE\x00"), "\U0001F600", grammars.UTF16BE}, // Surrogate pair
+		{[]byte("caf\xE9 \x80"), "café €", grammars.Windows1252},
+	}
+
+	for _, test := range tests {
+		got, charset := grammars.DecodeCharset(test.data)
+		if got != test.want || charset != test.charset {
+			t.Errorf("DecodeCharset(%q) = %q, %s, want %q, %s", test.data, got, charset, test.want, test.charset)
+		}
+	}
+}
+
+func TestEncodeCharset(t *testing.T) {
+	tests := [][]byte{
+		[]byte(`{"a": 1}`),
+		[]byte("\xFF\xFE{\x00}\x00"),
+		[]byte("\xFE\xFF\xD8\x3D\xDE\x00"),
+		[]byte("{\x00\"\x00a\x00\"\x00}\x00"),
+		[]byte("caf\xE9 \x80\x81"),
+	}
+
+	for _, data := range tests {
+		text, charset := grammars.DecodeCharset(data)
+		got, err := grammars.EncodeCharset(text, charset)
+		if err != nil {
+			t.Errorf("EncodeCharset(%q, %s) err = %s, want nil", text, charset, err)
+			continue
+		}
+		if want := data[len(grammars.ByteOrderMark(data)):]; string(got) != string(want) {
+			t.Errorf("EncodeCharset(%q, %s) = %q, want %q", text, charset, got, want)
+		}
+	}
+
+	if _, err := grammars.EncodeCharset("\U0001F600", grammars.Windows1252); err == nil {
+		t.Errorf("EncodeCharset(%q, %s) err = nil, want error", "\U0001F600", grammars.Windows1252)
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package chromalexer adapts any of the grammars' lexers into a lexer for the
+// github.com/alecthomas/chroma syntax highlighter. For example:
+//
+//	import (
+//		"bramp.net/antlr4/grammars"
+//		"bramp.net/antlr4/grammars/chromalexer"
+//		_ "bramp.net/antlr4/json"
+//
+//		"github.com/alecthomas/chroma/formatters/html"
+//		"github.com/alecthomas/chroma/styles"
+//	)
+//
+//	lexer := chromalexer.New(grammars.Lookup("json"))
+//	it, err := lexer.Tokenise(nil, `{"hello": "world"}`)
+//	...
+//	err = html.New().Format(w, styles.Get("github"), it)
+package chromalexer // import "bramp.net/antlr4/grammars/chromalexer"
+
+import (
+	"bramp.net/antlr4/grammars"
+	"bramp.net/antlr4/grammars/tokenclass"
+	"github.com/alecthomas/chroma"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// Lexer is a chroma.Lexer backed by a grammar's lexer.
+type Lexer struct {
+	grammar    *grammars.Grammar
+	classifier *tokenclass.Classifier
+	config     *chroma.Config
+}
+
+// New returns a chroma.Lexer that tokenises with the grammar's lexer.
+func New(g *grammars.Grammar) *Lexer {
+	return &Lexer{
+		grammar:    g,
+		classifier: tokenclass.New(g),
+		config: &chroma.Config{
+			Name:            g.LongName,
+			Aliases:         []string{g.Name},
+			CaseInsensitive: g.CaseInsensitiveType != "",
+		},
+	}
+}
+
+// Config returns the lexer's configuration.
+func (l *Lexer) Config() *chroma.Config {
+	return l.config
+}
+
+// Tokenise returns the tokens of the text. Text skipped by the lexer (such as
+// whitespace discarded with "-> skip", or invalid characters) is returned as
+// chroma.Text, so the tokens always cover the full text.
+func (l *Lexer) Tokenise(options *chroma.TokeniseOptions, text string) (chroma.Iterator, error) {
+	runes := []rune(text)
+
+	// Tokenize holds the grammar's locks, so its flags can't change mid-way.
+	toks, _ := l.grammar.Tokenize(antlr.NewInputStream(text))
+
+	var tokens []chroma.Token
+	last := 0 // Index of the first rune not yet returned
+	for _, tok := range toks {
+		start, stop := tok.GetStart(), tok.GetStop()
+		if start < last || stop < start || stop >= len(runes) {
+			// Empty, or otherwise odd tokens have no text to highlight.
+			continue
+		}
+		if start > last {
+			tokens = append(tokens, chroma.Token{Type: chroma.Text, Value: string(runes[last:start])})
+		}
+
+		tokens = append(tokens, chroma.Token{
+			Type:  classes[l.classifier.Class(tok.GetTokenType())],
+			Value: string(runes[start : stop+1]),
+		})
+		last = stop + 1
+	}
+	if last < len(runes) {
+		tokens = append(tokens, chroma.Token{Type: chroma.Text, Value: string(runes[last:])})
+	}
+
+	return chroma.Literator(tokens...), nil
+}
+
+// classes maps the generic token classes to chroma's token types.
+var classes = map[tokenclass.Class]chroma.TokenType{
+	tokenclass.Other:      chroma.Text,
+	tokenclass.Keyword:    chroma.Keyword,
+	tokenclass.Identifier: chroma.Name,
+	tokenclass.String:     chroma.LiteralString,
+	tokenclass.Number:     chroma.LiteralNumber,
+	tokenclass.Comment:    chroma.Comment,
+	tokenclass.Operator:   chroma.Operator,
+	tokenclass.Whitespace: chroma.Whitespace,
+}
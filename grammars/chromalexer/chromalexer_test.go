@@ -0,0 +1,55 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package chromalexer
+
+import (
+	"testing"
+
+	"bramp.net/antlr4/grammars"
+	_ "bramp.net/antlr4/json"
+	"github.com/alecthomas/chroma"
+	"github.com/kylelemons/godebug/pretty"
+)
+
+func TestTokenise(t *testing.T) {
+	const input = `{"a": [1, true]}`
+	want := []chroma.Token{
+		{Type: chroma.Operator, Value: "{"},
+		{Type: chroma.LiteralString, Value: `"a"`},
+		{Type: chroma.Operator, Value: ":"},
+		{Type: chroma.Text, Value: " "}, // WS is skipped by the lexer
+		{Type: chroma.Operator, Value: "["},
+		{Type: chroma.LiteralNumber, Value: "1"},
+		{Type: chroma.Operator, Value: ","},
+		{Type: chroma.Text, Value: " "}, // WS is skipped by the lexer
+		{Type: chroma.Keyword, Value: "true"},
+		{Type: chroma.Operator, Value: "]"},
+		{Type: chroma.Operator, Value: "}"},
+	}
+
+	lexer := New(grammars.Lookup("json"))
+	if got := lexer.Config().Name; got != "JSON" {
+		t.Errorf("Config().Name = %q, want %q", got, "JSON")
+	}
+
+	it, err := lexer.Tokenise(nil, input)
+	if err != nil {
+		t.Fatalf("Tokenise(%q) err = %s, want nil", input, err)
+	}
+
+	if diff := pretty.Compare(it.Tokens(), want); diff != "" {
+		t.Errorf("Tokenise(%q) diff: (-got +want)\n%s", input, diff)
+	}
+}
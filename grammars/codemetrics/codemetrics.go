@@ -0,0 +1,133 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package codemetrics measures parsed source code in the same way for every
+// grammar, counting nodes per rule, the depth of the tree, tokens by class,
+// and the density of comments.
+//
+//	result, err := g.ParseFile("example.c")
+//	m := codemetrics.Compute(result)
+//	fmt.Printf("%d lines, %.0f%% comments\n", m.Lines, 100*m.CommentDensity())
+//
+// Comments are found by classifying the tokens with the tokenclass package.
+// Tokens the grammar skips (with "-> skip") never reach the parser, so
+// grammars that skip their comments always report no comments.
+package codemetrics // import "bramp.net/antlr4/grammars/codemetrics"
+
+import (
+	"strings"
+
+	"bramp.net/antlr4/grammars"
+	"bramp.net/antlr4/grammars/tokenclass"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// Metrics describes a parse tree and its tokens.
+type Metrics struct {
+	Rules    map[string]int // Number of nodes for each rule name
+	Nodes    int            // Number of rule nodes
+	MaxDepth int            // Most rule nodes on a path from the root
+	Errors   int            // Number of error nodes
+
+	Tokens  int                      // Number of tokens, on any channel, excluding EOF
+	Classes map[tokenclass.Class]int // Number of tokens in each class
+
+	Lines        int // Lines containing something other than whitespace
+	CommentLines int // Lines containing a comment
+	CodeLines    int // Lines containing something other than comments or whitespace
+}
+
+// CommentDensity returns the fraction of lines that contain a comment.
+func (m *Metrics) CommentDensity() float64 {
+	if m.Lines == 0 {
+		return 0
+	}
+	return float64(m.CommentLines) / float64(m.Lines)
+}
+
+// Compute returns the metrics for the parsed result.
+func Compute(result *grammars.Result) *Metrics {
+	m := &Metrics{
+		Rules:   make(map[string]int),
+		Classes: make(map[tokenclass.Class]int),
+	}
+	m.walk(result.Tree, result.Parser.GetRuleNames(), 1)
+
+	result.Tokens.Fill()
+	m.addTokens(result.Tokens.GetAllTokens(), tokenclass.New(result.Grammar))
+	return m
+}
+
+func (m *Metrics) walk(t antlr.Tree, ruleNames []string, depth int) {
+	switch n := t.(type) {
+	case antlr.ErrorNode:
+		m.Errors++
+		return
+
+	case antlr.RuleContext:
+		m.Nodes++
+		if i := n.GetRuleIndex(); i >= 0 && i < len(ruleNames) {
+			m.Rules[ruleNames[i]]++
+		}
+		if depth > m.MaxDepth {
+			m.MaxDepth = depth
+		}
+	}
+
+	for _, c := range t.GetChildren() {
+		m.walk(c, ruleNames, depth+1)
+	}
+}
+
+func (m *Metrics) addTokens(tokens []antlr.Token, classifier *tokenclass.Classifier) {
+	comment := make(map[int]bool)
+	code := make(map[int]bool)
+
+	for _, tok := range tokens {
+		if tok.GetTokenType() == antlr.TokenEOF {
+			continue
+		}
+		m.Tokens++
+
+		class := classifier.Class(tok.GetTokenType())
+		m.Classes[class]++
+
+		text := tok.GetText()
+		if class == tokenclass.Whitespace || strings.TrimSpace(text) == "" {
+			continue
+		}
+
+		lines := code
+		if class == tokenclass.Comment {
+			lines = comment
+		}
+		// Mark every line the token spans, e.g for block comments.
+		last := tok.GetLine() + strings.Count(text, "\n")
+		if strings.HasSuffix(text, "\n") {
+			last-- // Line comments may include their newline
+		}
+		for line := tok.GetLine(); line <= last; line++ {
+			lines[line] = true
+		}
+	}
+
+	m.CommentLines = len(comment)
+	m.CodeLines = len(code)
+	m.Lines = len(code)
+	for line := range comment {
+		if !code[line] {
+			m.Lines++
+		}
+	}
+}
@@ -0,0 +1,67 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package codemetrics_test
+
+import (
+	"testing"
+
+	"bramp.net/antlr4/grammars"
+	"bramp.net/antlr4/grammars/codemetrics"
+	"bramp.net/antlr4/grammars/tokenclass"
+	_ "bramp.net/antlr4/json"
+	_ "bramp.net/antlr4/properties"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/kylelemons/godebug/pretty"
+)
+
+func compute(t *testing.T, name, input string) *codemetrics.Metrics {
+	result, err := grammars.Lookup(name).Parse(antlr.NewInputStream(input))
+	if err != nil {
+		t.Fatalf("Parse(%q) err = %s, want nil", input, err)
+	}
+	return codemetrics.Compute(result)
+}
+
+func TestCompute(t *testing.T) {
+	const input = "{\"a\": [1, 2],\n \"b\": true}"
+	m := compute(t, "json", input)
+
+	wantRules := map[string]int{"json": 1, "value": 5, "obj": 1, "pair": 2, "array": 1}
+	if diff := pretty.Compare(m.Rules, wantRules); diff != "" {
+		t.Errorf("Compute(%q).Rules diff: (-got +want)\n%s", input, diff)
+	}
+
+	if m.Nodes != 10 || m.MaxDepth != 7 || m.Errors != 0 {
+		t.Errorf("Compute(%q) = {Nodes: %d, MaxDepth: %d, Errors: %d}, want {10, 7, 0}", input, m.Nodes, m.MaxDepth, m.Errors)
+	}
+	if m.Tokens != 13 || m.Classes[tokenclass.String] != 2 || m.Classes[tokenclass.Number] != 2 {
+		t.Errorf("Compute(%q) = {Tokens: %d, Classes: %v}, want 13 tokens, with 2 strings and 2 numbers", input, m.Tokens, m.Classes)
+	}
+	if m.Lines != 2 || m.CodeLines != 2 || m.CommentDensity() != 0 {
+		t.Errorf("Compute(%q) = {Lines: %d, CodeLines: %d, CommentDensity: %v}, want {2, 2, 0}", input, m.Lines, m.CodeLines, m.CommentDensity())
+	}
+}
+
+func TestCommentDensity(t *testing.T) {
+	const input = "# a comment\nkey=value\n"
+	m := compute(t, "properties", input)
+
+	if m.Lines != 2 || m.CommentLines != 1 || m.CodeLines != 1 {
+		t.Errorf("Compute(%q) = {Lines: %d, CommentLines: %d, CodeLines: %d}, want {2, 1, 1}", input, m.Lines, m.CommentLines, m.CodeLines)
+	}
+	if got := m.CommentDensity(); got != 0.5 {
+		t.Errorf("Compute(%q).CommentDensity() = %v, want 0.5", input, got)
+	}
+}
@@ -0,0 +1,82 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grammars
+
+import (
+	"fmt"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// TokenMismatch is the first visible token that differs between two inputs.
+type TokenMismatch struct {
+	Index int         // Index of the token, counting only visible tokens
+	A, B  antlr.Token // The differing tokens, nil if that input ended first
+}
+
+func (m *TokenMismatch) Error() string {
+	return fmt.Sprintf("token %d differs: %s vs %s", m.Index, describeToken(m.A), describeToken(m.B))
+}
+
+func describeToken(t antlr.Token) string {
+	if t == nil {
+		return "end of input"
+	}
+	return fmt.Sprintf("%q (type %d) at %d:%d", t.GetText(), t.GetTokenType(), t.GetLine(), t.GetColumn())
+}
+
+// DiffTokens compares the type and text of the visible tokens, ignoring
+// those on hidden channels (such as whitespace and comments), and the tokens'
+// positions. It returns nil if the tokens are the same, otherwise the first
+// that differs.
+func DiffTokens(a, b []antlr.Token) *TokenMismatch {
+	a, b = VisibleTokens(a), VisibleTokens(b)
+	for i := 0; i < len(a) || i < len(b); i++ {
+		var ta, tb antlr.Token
+		if i < len(a) {
+			ta = a[i]
+		}
+		if i < len(b) {
+			tb = b[i]
+		}
+		if ta == nil || tb == nil || ta.GetTokenType() != tb.GetTokenType() || ta.GetText() != tb.GetText() {
+			return &TokenMismatch{Index: i, A: ta, B: tb}
+		}
+	}
+	return nil
+}
+
+// SameTokens lexes both inputs, and returns nil if they have the same visible
+// tokens, as compared by DiffTokens. Otherwise it returns the first syntax
+// error found by the lexer, or the first TokenMismatch. This allows formatter
+// and codemod authors to check their output is equivalent to the input:
+//
+//	if err := g.SameTokens(antlr.NewInputStream(before), antlr.NewInputStream(after)); err != nil {
+//		t.Errorf("format changed the meaning: %s", err)
+//	}
+func (g *Grammar) SameTokens(a, b antlr.CharStream) error {
+	ta, errors := g.Tokenize(a)
+	if len(errors) > 0 {
+		return errors[0]
+	}
+	tb, errors := g.Tokenize(b)
+	if len(errors) > 0 {
+		return errors[0]
+	}
+	if m := DiffTokens(ta, tb); m != nil {
+		return m
+	}
+	return nil
+}
@@ -0,0 +1,59 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grammars_test
+
+import (
+	"testing"
+
+	"bramp.net/antlr4/grammars"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+func TestSameTokens(t *testing.T) {
+	tests := []struct {
+		grammar string
+		a, b    string
+		same    bool
+	}{
+		{"json", `{"a": [1, 2]}`, "{\n  \"a\": [\n    1,\n    2\n  ]\n}", true},
+		{"json", `{"a": 1}`, `{"a": 2}`, false},
+		{"json", `{"a": 1}`, `{"a": 1, "b": 2}`, false},
+		{"json", `[1]`, `[1`, false},
+		{"ecmascript", "var a = 1; // one", "/* a */ var  a=1;", true},
+		{"ecmascript", "var a = 1;", "let a = 1;", false},
+	}
+
+	for _, test := range tests {
+		g := grammars.Lookup(test.grammar)
+		err := g.SameTokens(antlr.NewInputStream(test.a), antlr.NewInputStream(test.b))
+		if got := err == nil; got != test.same {
+			t.Errorf("%s.SameTokens(%q, %q) = %v, want same %v", test.grammar, test.a, test.b, err, test.same)
+		}
+	}
+}
+
+func TestDiffTokens(t *testing.T) {
+	g := grammars.Lookup("json")
+	a, _ := g.Tokenize(antlr.NewInputStream(`[1, 2]`))
+	b, _ := g.Tokenize(antlr.NewInputStream(`[1, 3]`))
+
+	m := grammars.DiffTokens(a, b)
+	if m == nil {
+		t.Fatalf("DiffTokens([1, 2], [1, 3]) = nil, want a mismatch")
+	}
+	if m.Index != 3 || m.A.GetText() != "2" || m.B.GetText() != "3" {
+		t.Errorf("DiffTokens([1, 2], [1, 3]) = %s, want token 3 to differ", m)
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package compat compares two revisions of a grammar, to decide if a new
+// revision is safe for existing users.
+//
+// The two revisions can't be linked into the same program, so each is
+// described by a Signature, typically saved as JSON from each revision, then
+// compared:
+//
+//	old := compat.Take(g, examples) // At the old revision
+//	...
+//	report := compat.Compare(old, new)
+//
+// A change is breaking if code written for the old revision may stop
+// working: a rule or token was removed, the entry point changed, or an
+// example that parsed now parses to a different tree, or fails. Renumbered
+// rules and tokens are compatible, as the generated constants change with
+// them, and just require recompiling.
+package compat // import "bramp.net/antlr4/grammars/compat"
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"sort"
+
+	"bramp.net/antlr4/grammars"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// Signature describes the parts of a grammar that users depend on.
+type Signature struct {
+	Grammar    string         `json:"grammar"`
+	EntryPoint string         `json:"entry_point,omitempty"`
+	Rules      map[string]int `json:"rules"`  // Rule name to index
+	Tokens     map[string]int `json:"tokens"` // Token name to type
+
+	// Trees maps the name of each example to a hash of its parse tree, or
+	// empty if it had syntax errors.
+	Trees map[string]string `json:"trees,omitempty"`
+}
+
+// Take returns the signature of the grammar, parsing the examples (keyed by
+// name) to record the shape of their trees.
+func Take(g *grammars.Grammar, examples map[string][]byte) (*Signature, error) {
+	lexer := g.NewLexer(antlr.NewInputStream(""))
+
+	s := &Signature{
+		Grammar:    g.Name,
+		EntryPoint: g.EntryPoint,
+		Rules:      make(map[string]int),
+		Tokens:     tokens(lexer),
+		Trees:      make(map[string]string),
+	}
+
+	if !g.HasParser() {
+		return s, nil
+	}
+
+	parser := g.NewParser(antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel))
+	for i, name := range parser.GetRuleNames() {
+		s.Rules[name] = i
+	}
+
+	for name, data := range examples {
+		result, err := g.Parse(antlr.NewInputStream(string(data)))
+		if err != nil {
+			return nil, err
+		}
+		if len(result.Errors) > 0 {
+			s.Trees[name] = ""
+			continue
+		}
+		tree := result.Tree.ToStringTree(nil, result.Parser)
+		s.Trees[name] = fmt.Sprintf("%x", sha1.Sum([]byte(tree)))
+	}
+	return s, nil
+}
+
+// tokens returns the token types by name, using the symbolic name, or if
+// there is none, the literal name.
+func tokens(recognizer antlr.Recognizer) map[string]int {
+	m := make(map[string]int)
+	symbolic := recognizer.GetSymbolicNames()
+	for i, name := range recognizer.GetLiteralNames() {
+		if name != "" && (i >= len(symbolic) || symbolic[i] == "") {
+			m[name] = i
+		}
+	}
+	for i, name := range symbolic {
+		if name != "" {
+			m[name] = i
+		}
+	}
+	return m
+}
+
+// Change is one difference between two signatures.
+type Change struct {
+	Breaking bool
+	Msg      string
+}
+
+func (c *Change) String() string {
+	if c.Breaking {
+		return "breaking: " + c.Msg
+	}
+	return "compatible: " + c.Msg
+}
+
+// Report lists the changes between two signatures.
+type Report struct {
+	Changes []*Change
+}
+
+// Breaking returns true if any of the changes are breaking.
+func (r *Report) Breaking() bool {
+	for _, c := range r.Changes {
+		if c.Breaking {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Report) add(breaking bool, format string, args ...interface{}) {
+	r.Changes = append(r.Changes, &Change{
+		Breaking: breaking,
+		Msg:      fmt.Sprintf(format, args...),
+	})
+}
+
+// Compare returns the changes from the old to the new signature, breaking
+// changes first.
+func Compare(old, new *Signature) *Report {
+	r := &Report{}
+
+	if old.EntryPoint != new.EntryPoint {
+		r.add(true, "entry point changed from %q to %q", old.EntryPoint, new.EntryPoint)
+	}
+
+	compareNames(r, "rule", old.Rules, new.Rules)
+	compareNames(r, "token", old.Tokens, new.Tokens)
+
+	for _, name := range sortedKeys(old.Trees) {
+		oldTree, newTree := old.Trees[name], new.Trees[name]
+		_, found := new.Trees[name]
+		switch {
+		case !found:
+			r.add(false, "example %s removed", name)
+		case oldTree == newTree:
+		case oldTree == "":
+			r.add(false, "example %s now parses without errors", name)
+		case newTree == "":
+			r.add(true, "example %s now has syntax errors", name)
+		default:
+			r.add(true, "example %s parses to a different tree", name)
+		}
+	}
+	for _, name := range sortedKeys(new.Trees) {
+		if _, found := old.Trees[name]; !found {
+			r.add(false, "example %s added", name)
+		}
+	}
+
+	sort.SliceStable(r.Changes, func(i, j int) bool {
+		return r.Changes[i].Breaking && !r.Changes[j].Breaking
+	})
+	return r
+}
+
+func compareNames(r *Report, kind string, old, new map[string]int) {
+	for _, name := range sortedKeys(old) {
+		n, found := new[name]
+		switch {
+		case !found:
+			r.add(true, "%s %s removed", kind, name)
+		case n != old[name]:
+			r.add(false, "%s %s renumbered from %d to %d", kind, name, old[name], n)
+		}
+	}
+	for _, name := range sortedKeys(new) {
+		if _, found := old[name]; !found {
+			r.add(false, "%s %s added", kind, name)
+		}
+	}
+}
+
+// sortedKeys returns the keys of a map[string]int or map[string]string.
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	switch m := m.(type) {
+	case map[string]int:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	case map[string]string:
+		for k := range m {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,93 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compat
+
+import (
+	"testing"
+
+	"bramp.net/antlr4/grammars"
+	_ "bramp.net/antlr4/json"
+	"github.com/kylelemons/godebug/pretty"
+)
+
+func TestTake(t *testing.T) {
+	examples := map[string][]byte{
+		"good.json": []byte(`{"a": [1, 2]}`),
+		"bad.json":  []byte(`{"a": [1, 2}`),
+	}
+	s, err := Take(grammars.Lookup("json"), examples)
+	if err != nil {
+		t.Fatalf("Take(json) err = %s, want nil", err)
+	}
+
+	if s.Grammar != "json" || s.EntryPoint != "json" {
+		t.Errorf("Take(json) = {Grammar: %q, EntryPoint: %q}, want json", s.Grammar, s.EntryPoint)
+	}
+	if s.Rules["pair"] != 2 || s.Tokens["STRING"] != 10 || s.Tokens["'{'"] != 1 {
+		t.Errorf("Take(json) = {Rules: %v, Tokens: %v}, want pair=2, STRING=10 and '{'=1", s.Rules, s.Tokens)
+	}
+	if s.Trees["good.json"] == "" || s.Trees["bad.json"] != "" {
+		t.Errorf("Take(json).Trees = %v, want a hash for good.json only", s.Trees)
+	}
+
+	if r := Compare(s, s); len(r.Changes) != 0 {
+		t.Errorf("Compare(s, s) = %v, want no changes", r.Changes)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	old := &Signature{
+		EntryPoint: "json",
+		Rules:      map[string]int{"json": 0, "obj": 1, "pair": 2},
+		Tokens:     map[string]int{"STRING": 1, "NUMBER": 2},
+		Trees:      map[string]string{"a": "1", "b": "", "c": "3", "d": "4", "e": "5"},
+	}
+	new := &Signature{
+		EntryPoint: "json",
+		Rules:      map[string]int{"json": 0, "pair": 1, "value": 2},
+		Tokens:     map[string]int{"STRING": 2, "NUMBER": 1, "WS": 3},
+		Trees:      map[string]string{"a": "1", "b": "2", "c": "", "d": "x", "f": "6"},
+	}
+
+	r := Compare(old, new)
+	var got []string
+	for _, c := range r.Changes {
+		got = append(got, c.String())
+	}
+
+	want := []string{
+		"breaking: rule obj removed",
+		"breaking: example c now has syntax errors",
+		"breaking: example d parses to a different tree",
+		"compatible: rule pair renumbered from 2 to 1",
+		"compatible: rule value added",
+		"compatible: token NUMBER renumbered from 2 to 1",
+		"compatible: token STRING renumbered from 1 to 2",
+		"compatible: token WS added",
+		"compatible: example b now parses without errors",
+		"compatible: example e removed",
+		"compatible: example f added",
+	}
+	if diff := pretty.Compare(got, want); diff != "" {
+		t.Errorf("Compare(old, new) diff: (-got +want)\n%s", diff)
+	}
+	if !r.Breaking() {
+		t.Errorf("Compare(old, new).Breaking() = false, want true")
+	}
+
+	if r := Compare(old, &Signature{EntryPoint: "obj", Rules: old.Rules, Tokens: old.Tokens, Trees: old.Trees}); !r.Breaking() {
+		t.Errorf("Compare(old, new entry point).Breaking() = false, want true")
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package corpus_test
+
+import (
+	"testing"
+
+	"bramp.net/antlr4/grammars/corpus"
+)
+
+func TestCorpus(t *testing.T) {
+	if !corpus.Available() {
+		if _, err := corpus.ReadFile("json", "example1.json"); err == nil {
+			t.Errorf("ReadFile(...) err = nil, want error when the corpus isn't embedded")
+		}
+		return
+	}
+
+	for _, g := range corpus.Grammars() {
+		files := corpus.Files(g)
+		if len(files) == 0 {
+			t.Errorf("Files(%q) = [], want at least one file", g)
+		}
+		for _, name := range files {
+			data, err := corpus.ReadFile(g, name)
+			if err != nil || len(data) == 0 {
+				t.Errorf("ReadFile(%q, %q) = %d bytes, %v, want the example", g, name, len(data), err)
+			}
+		}
+	}
+}
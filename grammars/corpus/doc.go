@@ -0,0 +1,28 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package corpus provides a small, curated set of each grammar's example
+// files from grammars-v4, for smoke testing and benchmarking an integration
+// without checking out grammars-v4.
+//
+// The examples are only embedded when built with the corpus tag (which
+// requires Go 1.16 or later), to avoid adding them to every binary:
+//
+//	go test -tags corpus ./...
+//
+// Without the tag, Grammars returns nothing, and ReadFile returns an error.
+//
+// The examples are copied by "make", up to three of the smallest files of
+// each grammar that passed its tests.
+package corpus // import "bramp.net/antlr4/grammars/corpus"
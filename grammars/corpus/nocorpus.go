@@ -0,0 +1,42 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build !corpus
+// +build !corpus
+
+package corpus
+
+import "errors"
+
+var errNotEmbedded = errors.New("corpus: not embedded, build with -tags corpus")
+
+// Available returns true if the corpus was embedded.
+func Available() bool {
+	return false
+}
+
+// Grammars returns the names of the grammars in the corpus, sorted.
+func Grammars() []string {
+	return nil
+}
+
+// Files returns the names of the grammar's example files, sorted.
+func Files(grammar string) []string {
+	return nil
+}
+
+// ReadFile returns the content of one of the grammar's example files.
+func ReadFile(grammar, name string) ([]byte, error) {
+	return nil, errNotEmbedded
+}
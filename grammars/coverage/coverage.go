@@ -0,0 +1,120 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package coverage measures which rules of a grammar are exercised by some
+// input, and finds small sets of inputs that exercise the same rules.
+package coverage // import "bramp.net/antlr4/grammars/coverage"
+
+import (
+	"sort"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// Set is a set of rule indexes.
+type Set map[int]bool
+
+// Rules returns the set of rules used in the parse tree.
+func Rules(t antlr.Tree) Set {
+	s := make(Set)
+	var walk func(t antlr.Tree)
+	walk = func(t antlr.Tree) {
+		if r, ok := t.(antlr.RuleContext); ok {
+			s[r.GetRuleIndex()] = true
+		}
+		for _, c := range t.GetChildren() {
+			walk(c)
+		}
+	}
+	walk(t)
+	return s
+}
+
+// Add adds all the rules in o to s.
+func (s Set) Add(o Set) {
+	for r := range o {
+		s[r] = true
+	}
+}
+
+// Sorted returns the rule indexes in increasing order.
+func (s Set) Sorted() []int {
+	var rules []int
+	for r := range s {
+		rules = append(rules, r)
+	}
+	sort.Ints(rules)
+	return rules
+}
+
+// Names returns the names of the rules, as defined by the recognizer.
+func (s Set) Names(recognizer antlr.Recognizer) []string {
+	ruleNames := recognizer.GetRuleNames()
+
+	var names []string
+	for _, r := range s.Sorted() {
+		if r >= 0 && r < len(ruleNames) {
+			names = append(names, ruleNames[r])
+		}
+	}
+	return names
+}
+
+// Input is a single input, and the rules it covers.
+type Input struct {
+	Name  string
+	Size  int // Size of the input, smaller inputs are preferred
+	Rules Set
+
+	// Shingles of the input's tokens, used by Representative to find near
+	// duplicates.
+	Shingles Shingles
+}
+
+// Minimize returns a subset of the inputs that together cover every rule
+// covered by all the inputs. It greedily picks the input that covers the most
+// uncovered rules, preferring smaller inputs, so the result is small but not
+// guaranteed to be the smallest possible.
+func Minimize(inputs []*Input) []*Input {
+	remaining := append([]*Input(nil), inputs...)
+	sort.SliceStable(remaining, func(i, j int) bool {
+		return remaining[i].Size < remaining[j].Size
+	})
+
+	covered := make(Set)
+	var result []*Input
+	for {
+		best, bestNew := -1, 0
+		for i, in := range remaining {
+			n := 0
+			for r := range in.Rules {
+				if !covered[r] {
+					n++
+				}
+			}
+			// Strictly greater, so the smallest input wins a tie.
+			if n > bestNew {
+				best, bestNew = i, n
+			}
+		}
+		if best < 0 {
+			return result
+		}
+
+		in := remaining[best]
+		covered.Add(in.Rules)
+		result = append(result, in)
+		remaining = append(remaining[:best], remaining[best+1:]...)
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coverage
+
+import (
+	"testing"
+
+	"bramp.net/antlr4/json"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/kylelemons/godebug/pretty"
+)
+
+func TestRules(t *testing.T) {
+	tests := []struct {
+		input string
+		want  []string
+	}{
+		{`1`, []string{"json", "value"}},
+		{`[1]`, []string{"json", "array", "value"}},
+		{`{"a": [1]}`, []string{"json", "obj", "pair", "array", "value"}},
+	}
+
+	for _, test := range tests {
+		p := json.NewJSONParser(antlr.NewCommonTokenStream(json.NewJSONLexer(antlr.NewInputStream(test.input)), antlr.TokenDefaultChannel))
+		got := Rules(p.Json()).Names(p)
+
+		if diff := pretty.Compare(got, test.want); diff != "" {
+			t.Errorf("Rules(%q) diff: (-got +want)\n%s", test.input, diff)
+		}
+	}
+}
+
+func TestMinimize(t *testing.T) {
+	set := func(rules ...int) Set {
+		s := make(Set)
+		for _, r := range rules {
+			s[r] = true
+		}
+		return s
+	}
+
+	inputs := []*Input{
+		{Name: "a", Size: 10, Rules: set(1, 2)},
+		{Name: "b", Size: 50, Rules: set(1, 2, 3, 4)},
+		{Name: "c", Size: 5, Rules: set(1, 2)}, // Same as a, but smaller
+		{Name: "d", Size: 20, Rules: set(5)},
+		{Name: "e", Size: 1, Rules: set()},
+		{Name: "f", Size: 30, Rules: set(3, 4, 5)},
+	}
+
+	var got []string
+	for _, in := range Minimize(inputs) {
+		got = append(got, in.Name)
+	}
+
+	want := []string{"b", "d"}
+	if diff := pretty.Compare(got, want); diff != "" {
+		t.Errorf("Minimize(...) diff: (-got +want)\n%s", diff)
+	}
+}
+
+func TestShingles(t *testing.T) {
+	shingles := func(input string) Shingles {
+		tokens := antlr.NewCommonTokenStream(json.NewJSONLexer(antlr.NewInputStream(input)), antlr.TokenDefaultChannel)
+		tokens.Fill()
+		return NewShingles(tokens.GetAllTokens(), 3)
+	}
+
+	tests := []struct {
+		a, b string
+		want float64
+	}{
+		{`[1, 2, 3]`, `[1,2,3]`, 1},         // Whitespace is ignored
+		{`[1, 2, 3]`, `[1, 2, 4]`, 3.0 / 7}, // Sharing 3 of the 7 shingles
+		{`[1, 2, 3]`, `{"a": true}`, 0},
+		{`1`, `1`, 1}, // Fewer than k tokens
+		{``, ``, 1},
+	}
+	for _, test := range tests {
+		if got := shingles(test.a).Similarity(shingles(test.b)); got != test.want {
+			t.Errorf("Similarity(%q, %q) = %v, want %v", test.a, test.b, got, test.want)
+		}
+	}
+}
+
+func TestRepresentative(t *testing.T) {
+	set := func(rules ...int) Set {
+		s := make(Set)
+		for _, r := range rules {
+			s[r] = true
+		}
+		return s
+	}
+	shingles := func(hashes ...uint64) Shingles {
+		s := make(Shingles)
+		for _, h := range hashes {
+			s[h] = true
+		}
+		return s
+	}
+
+	inputs := []*Input{
+		{Name: "a", Size: 10, Rules: set(1, 2), Shingles: shingles(1, 2, 3, 4)},
+		{Name: "b", Size: 11, Rules: set(1, 2), Shingles: shingles(1, 2, 3, 4, 5)}, // Near duplicate of a
+		{Name: "c", Size: 12, Rules: set(1, 2), Shingles: shingles(6, 7, 8)},
+		{Name: "d", Size: 50, Rules: set(1, 2, 3), Shingles: shingles(1, 2, 3, 4)}, // Covers rule 3
+	}
+
+	var got []string
+	for _, in := range Representative(inputs, 0.5) {
+		got = append(got, in.Name)
+	}
+
+	want := []string{"c", "d"} // a is a duplicate of d, which is kept for its coverage
+	if diff := pretty.Compare(got, want); diff != "" {
+		t.Errorf("Representative(...) diff: (-got +want)\n%s", diff)
+	}
+}
@@ -0,0 +1,118 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coverage
+
+import (
+	"hash/fnv"
+	"sort"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// Shingles is a set of hashes of each run of consecutive tokens in an input.
+// Inputs sharing most of their shingles are near duplicates, even if they
+// differ in whitespace, comments, or a few tokens.
+type Shingles map[uint64]bool
+
+// NewShingles returns the shingles of every k consecutive tokens on the
+// default channel, identified by their type and text. Inputs with fewer than
+// k tokens have a single shingle of all of them.
+func NewShingles(tokens []antlr.Token, k int) Shingles {
+	var visible []antlr.Token
+	for _, t := range tokens {
+		if t.GetChannel() == antlr.TokenDefaultChannel && t.GetTokenType() != antlr.TokenEOF {
+			visible = append(visible, t)
+		}
+	}
+
+	s := make(Shingles)
+	if len(visible) < k {
+		k = len(visible)
+	}
+	for i := 0; i+k <= len(visible); i++ {
+		h := fnv.New64a()
+		for _, t := range visible[i : i+k] {
+			h.Write([]byte{byte(t.GetTokenType()), byte(t.GetTokenType() >> 8), 0})
+			h.Write([]byte(t.GetText()))
+			h.Write([]byte{0})
+		}
+		s[h.Sum64()] = true
+		if k == 0 {
+			break
+		}
+	}
+	return s
+}
+
+// Similarity returns the Jaccard similarity of the two sets of shingles,
+// from 0 when they share none, to 1 when they are identical.
+func (s Shingles) Similarity(o Shingles) float64 {
+	if len(s) == 0 && len(o) == 0 {
+		return 1
+	}
+	if len(o) < len(s) {
+		s, o = o, s
+	}
+	shared := 0
+	for h := range s {
+		if o[h] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(s)+len(o)-shared)
+}
+
+// Representative returns a subset of the inputs, which covers every rule
+// covered by all the inputs, and has no two inputs more similar than the
+// threshold. It starts with the inputs returned by Minimize, then adds the
+// remaining inputs, smallest first, unless they are a near duplicate of one
+// already chosen. The result is in the same order as the inputs.
+func Representative(inputs []*Input, threshold float64) []*Input {
+	chosen := make(map[*Input]bool)
+	var kept []*Input
+	for _, in := range Minimize(inputs) {
+		chosen[in] = true
+		kept = append(kept, in)
+	}
+
+	remaining := append([]*Input(nil), inputs...)
+	sort.SliceStable(remaining, func(i, j int) bool {
+		return remaining[i].Size < remaining[j].Size
+	})
+	for _, in := range remaining {
+		if chosen[in] {
+			continue
+		}
+		duplicate := false
+		for _, k := range kept {
+			if in.Shingles.Similarity(k.Shingles) > threshold {
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			chosen[in] = true
+			kept = append(kept, in)
+		}
+	}
+
+	var result []*Input
+	for _, in := range inputs {
+		if chosen[in] {
+			result = append(result, in)
+		}
+	}
+	return result
+}
@@ -0,0 +1,46 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grammars_test
+
+import (
+	"sort"
+	"testing"
+
+	"bramp.net/antlr4/grammars"
+)
+
+func TestDisabled(t *testing.T) {
+	disabled := grammars.Disabled()
+	if len(disabled) == 0 {
+		t.Fatalf("Disabled() = [], want some grammars")
+	}
+
+	if !sort.SliceIsSorted(disabled, func(i, j int) bool { return disabled[i].Name < disabled[j].Name }) {
+		t.Errorf("Disabled() is not sorted by name")
+	}
+
+	for _, d := range disabled {
+		if d.Reason == "" {
+			t.Errorf("Disabled() %q has no reason", d.Name)
+		}
+		if got := grammars.LookupDisabled(d.Name); got != d {
+			t.Errorf("LookupDisabled(%q) = %v, want %v", d.Name, got, d)
+		}
+	}
+
+	if got := grammars.LookupDisabled("json"); got != nil {
+		t.Errorf("LookupDisabled(%q) = %v, want nil", "json", got)
+	}
+}
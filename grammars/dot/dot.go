@@ -0,0 +1,88 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dot writes parse trees in the Graphviz DOT language, so they can be
+// rendered as images, for example:
+//
+//	dot -Tsvg tree.dot > tree.svg
+package dot // import "bramp.net/antlr4/grammars/dot"
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// Label returns the text used to describe the node. That's the rule name for
+// rules, and the token's text for tokens.
+func Label(t antlr.Tree, recognizer antlr.Recognizer) string {
+	switch n := t.(type) {
+	case antlr.RuleContext:
+		ruleNames := recognizer.GetRuleNames()
+		if i := n.GetRuleIndex(); i >= 0 && i < len(ruleNames) {
+			return ruleNames[i]
+		}
+		return fmt.Sprintf("rule%d", n.GetRuleIndex())
+
+	case antlr.TerminalNode:
+		if n.GetSymbol().GetTokenType() == antlr.TokenEOF {
+			return "<EOF>"
+		}
+		return n.GetText()
+	}
+	return fmt.Sprintf("%T", t)
+}
+
+// Write writes the tree as a DOT digraph. Rules are drawn as ellipses, tokens
+// as boxes, and error nodes in red.
+func Write(w io.Writer, t antlr.Tree, recognizer antlr.Recognizer) error {
+	out := bufio.NewWriter(w)
+	fmt.Fprintln(out, "digraph tree {")
+	fmt.Fprintln(out, "\tordering=out;")
+	fmt.Fprintln(out, "\tnode [fontname=\"Helvetica\"];")
+
+	id := 0
+	var walk func(t antlr.Tree) int
+	walk = func(t antlr.Tree) int {
+		me := id
+		id++
+
+		attrs := "shape=ellipse"
+		switch t.(type) {
+		case antlr.ErrorNode:
+			attrs = "shape=box, color=red, fontcolor=red"
+		case antlr.TerminalNode:
+			attrs = "shape=box"
+		}
+		fmt.Fprintf(out, "\tn%d [label=%s, %s];\n", me, quote(Label(t, recognizer)), attrs)
+
+		for _, c := range t.GetChildren() {
+			fmt.Fprintf(out, "\tn%d -> n%d;\n", me, walk(c))
+		}
+		return me
+	}
+	walk(t)
+
+	fmt.Fprintln(out, "}")
+	return out.Flush()
+}
+
+// quote returns s as a DOT double quoted string.
+func quote(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`)
+	return `"` + r.Replace(s) + `"`
+}
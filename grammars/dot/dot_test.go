@@ -0,0 +1,80 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dot
+
+import (
+	"bytes"
+	"testing"
+
+	"bramp.net/antlr4/json"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+func TestWrite(t *testing.T) {
+	input := antlr.NewInputStream(`{"a\"": 1}`)
+	p := json.NewJSONParser(antlr.NewCommonTokenStream(json.NewJSONLexer(input), antlr.TokenDefaultChannel))
+	tree := p.Json()
+
+	var buf bytes.Buffer
+	if err := Write(&buf, tree, p); err != nil {
+		t.Fatalf("Write(...) err = %s, want nil", err)
+	}
+
+	want := `digraph tree {
+	ordering=out;
+	node [fontname="Helvetica"];
+	n0 [label="json", shape=ellipse];
+	n1 [label="value", shape=ellipse];
+	n2 [label="obj", shape=ellipse];
+	n3 [label="{", shape=box];
+	n2 -> n3;
+	n4 [label="pair", shape=ellipse];
+	n5 [label="\"a\\\"\"", shape=box];
+	n4 -> n5;
+	n6 [label=":", shape=box];
+	n4 -> n6;
+	n7 [label="value", shape=ellipse];
+	n8 [label="1", shape=box];
+	n7 -> n8;
+	n4 -> n7;
+	n2 -> n4;
+	n9 [label="}", shape=box];
+	n2 -> n9;
+	n1 -> n2;
+	n0 -> n1;
+}
+`
+	if got := buf.String(); got != want {
+		t.Errorf("Write(%q) = \n%s\nwant:\n%s", `{"a\"": 1}`, got, want)
+	}
+}
+
+func TestQuote(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"abc", `"abc"`},
+		{`a"b`, `"a\"b"`},
+		{`a\b`, `"a\\b"`},
+		{"a\nb", `"a\nb"`},
+	}
+
+	for _, test := range tests {
+		if got := quote(test.input); got != test.want {
+			t.Errorf("quote(%q) = %q, want %q", test.input, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,90 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package expvarmetrics publishes parsing metrics with the expvar package.
+// Each grammar gets a map of counters, for example:
+//
+//	"antlr4": {
+//		"json": {"parses": 10, "failures": 1, "parse_seconds": 0.012, "dfa_states": 182}
+//	}
+package expvarmetrics // import "bramp.net/antlr4/grammars/expvarmetrics"
+
+import (
+	"expvar"
+	"sync"
+	"time"
+
+	"bramp.net/antlr4/grammars"
+)
+
+// Metrics is a grammars.Metrics that publishes counters with expvar.
+type Metrics struct {
+	vars *expvar.Map
+
+	mu       sync.Mutex
+	grammars map[string]*counters
+}
+
+type counters struct {
+	parses   expvar.Int
+	failures expvar.Int
+	seconds  expvar.Float
+	states   expvar.Int
+}
+
+// New returns Metrics published under the given expvar name. Like
+// expvar.Publish, it panics if the name is already in use.
+func New(name string) *Metrics {
+	m := &Metrics{
+		vars:     new(expvar.Map).Init(),
+		grammars: make(map[string]*counters),
+	}
+	expvar.Publish(name, m.vars)
+	return m
+}
+
+// counters returns the grammar's counters, creating them on first use.
+func (m *Metrics) counters(g *grammars.Grammar) *counters {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	c, ok := m.grammars[g.Name]
+	if !ok {
+		c = &counters{}
+		vars := new(expvar.Map).Init()
+		vars.Set("parses", &c.parses)
+		vars.Set("failures", &c.failures)
+		vars.Set("parse_seconds", &c.seconds)
+		vars.Set("dfa_states", &c.states)
+
+		m.grammars[g.Name] = c
+		m.vars.Set(g.Name, vars)
+	}
+	return c
+}
+
+// ObserveParse counts the parse, and if there were syntax errors, a failure.
+func (m *Metrics) ObserveParse(g *grammars.Grammar, duration time.Duration, errors int) {
+	c := m.counters(g)
+	c.parses.Add(1)
+	if errors > 0 {
+		c.failures.Add(1)
+	}
+	c.seconds.Add(duration.Seconds())
+}
+
+// ObserveCacheSize records the latest number of cached DFA states.
+func (m *Metrics) ObserveCacheSize(g *grammars.Grammar, states int) {
+	m.counters(g).states.Set(int64(states))
+}
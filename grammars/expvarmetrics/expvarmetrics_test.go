@@ -0,0 +1,56 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package expvarmetrics_test
+
+import (
+	"encoding/json"
+	"expvar"
+	"testing"
+
+	"bramp.net/antlr4/grammars"
+	"bramp.net/antlr4/grammars/expvarmetrics"
+	_ "bramp.net/antlr4/json"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+func TestMetrics(t *testing.T) {
+	m := expvarmetrics.New("antlr4_test")
+
+	g := grammars.Lookup("json")
+	for _, input := range []string{`{"a": [1, 2]}`, `{"a": [1, 2}`, `[]`} {
+		if _, err := g.Parse(antlr.NewInputStream(input), grammars.WithMetrics(m)); err != nil {
+			t.Fatalf("Parse(%q) err = %s, want nil", input, err)
+		}
+	}
+
+	var got map[string]struct {
+		Parses    int     `json:"parses"`
+		Failures  int     `json:"failures"`
+		Seconds   float64 `json:"parse_seconds"`
+		DFAStates int     `json:"dfa_states"`
+	}
+	vars := expvar.Get("antlr4_test").String()
+	if err := json.Unmarshal([]byte(vars), &got); err != nil {
+		t.Fatalf("json.Unmarshal(%q) err = %s, want nil", vars, err)
+	}
+
+	stats := got["json"]
+	if stats.Parses != 3 || stats.Failures != 1 {
+		t.Errorf("expvar %s counted %d parses with %d failures, want 3 with 1", vars, stats.Parses, stats.Failures)
+	}
+	if stats.Seconds <= 0 || stats.DFAStates <= 0 {
+		t.Errorf("expvar %s parse_seconds and dfa_states should be positive", vars)
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grammars
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// flagsMu guards the grammars' Flags. As they are package level variables
+// shared by every parse, parses setting a flag run one at a time, and wait
+// for the other parses and tokenizations of grammars with flags to finish.
+var flagsMu sync.RWMutex
+
+// WithFlag sets one of the grammar's Flags for the parse, for example to
+// parse ecmascript in sloppy mode:
+//
+//	g.Parse(input, grammars.WithFlag("strictMode", false))
+//
+// The flag is restored to its previous value once parsing has finished.
+func WithFlag(name string, value bool) Option {
+	return func(o *options) {
+		if o.flags == nil {
+			o.flags = make(map[string]bool)
+		}
+		o.flags[name] = value
+	}
+}
+
+// FlagNames returns the sorted names of the grammar's Flags.
+func (g *Grammar) FlagNames() []string {
+	var names []string
+	for name := range g.Flags {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// readFlags takes the read lock on the grammar's Flags, if it has any, so they
+// can't change while its lexer or parser is running. It returns a function to
+// release the lock.
+func (g *Grammar) readFlags() (unlock func()) {
+	if len(g.Flags) == 0 {
+		return func() {}
+	}
+	flagsMu.RLock()
+	return flagsMu.RUnlock
+}
+
+// setFlags sets the flags, returning a function to restore them, which must
+// be called once parsing has finished.
+func (g *Grammar) setFlags(flags map[string]bool) (restore func(), err error) {
+	for name := range flags {
+		if g.Flags[name] == nil {
+			return nil, fmt.Errorf("%s: unknown flag %q, want one of %q", g.Name, name, g.FlagNames())
+		}
+	}
+
+	if len(flags) == 0 {
+		return g.readFlags(), nil
+	}
+
+	flagsMu.Lock()
+	previous := make(map[string]bool, len(flags))
+	for name, value := range flags {
+		previous[name] = *g.Flags[name]
+		*g.Flags[name] = value
+	}
+	return func() {
+		for name, value := range previous {
+			*g.Flags[name] = value
+		}
+		flagsMu.Unlock()
+	}, nil
+}
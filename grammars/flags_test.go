@@ -0,0 +1,53 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grammars_test
+
+import (
+	"testing"
+
+	"bramp.net/antlr4/grammars"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+func TestWithFlag(t *testing.T) {
+	json := grammars.Lookup("json")
+
+	flag := true
+	var during bool
+	g := &grammars.Grammar{
+		Name:      "flagged",
+		NewLexer:  json.NewLexer,
+		NewParser: json.NewParser,
+		Start: func(parser antlr.Parser) antlr.ParserRuleContext {
+			during = flag
+			return json.Start(parser)
+		},
+		Flags: map[string]*bool{"flag": &flag},
+	}
+
+	if _, err := g.Parse(antlr.NewInputStream("{}"), grammars.WithFlag("flag", false)); err != nil {
+		t.Fatalf("Parse(..., WithFlag(flag, false)) = %s", err)
+	}
+	if during {
+		t.Errorf("Parse(..., WithFlag(flag, false)) flag = true while parsing, want false")
+	}
+	if !flag {
+		t.Errorf("Parse(..., WithFlag(flag, false)) flag = false after parsing, want it restored to true")
+	}
+
+	if _, err := g.Parse(antlr.NewInputStream("{}"), grammars.WithFlag("unknown", false)); err == nil {
+		t.Errorf("Parse(..., WithFlag(unknown, false)) = nil error, want unknown flag error")
+	}
+}
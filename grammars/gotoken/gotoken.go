@@ -0,0 +1,129 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package gotoken converts between the positions of ANTLR tokens, and the
+// go/token package's Pos, so trees from any grammar can be used by tools
+// that already track positions with a token.FileSet.
+//
+// ANTLR positions are indexes of Unicode code points in the input, while
+// token.Pos are based on byte offsets, so a File records where each code
+// point starts.
+package gotoken // import "bramp.net/antlr4/grammars/gotoken"
+
+import (
+	"go/token"
+	"sort"
+	"unicode/utf8"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// File is a file in a token.FileSet, whose content was lexed by ANTLR.
+type File struct {
+	file    *token.File
+	offsets []int // Byte offset of each code point, plus the end of the file
+}
+
+// AddFile adds a file with the given source to the fileset. The source should
+// be the same as given to ANTLR, e.g with antlr.NewInputStream(string(src)).
+func AddFile(fset *token.FileSet, filename string, src []byte) *File {
+	f := &File{
+		file: fset.AddFile(filename, -1, len(src)),
+	}
+	f.file.SetLinesForContent(src)
+
+	for i := 0; i < len(src); {
+		f.offsets = append(f.offsets, i)
+		_, size := utf8.DecodeRune(src[i:])
+		i += size
+	}
+	f.offsets = append(f.offsets, len(src))
+	return f
+}
+
+// TokenFile returns the underlying token.File.
+func (f *File) TokenFile() *token.File {
+	return f.file
+}
+
+// Offset returns the byte offset of the code point at the ANTLR index.
+func (f *File) Offset(index int) int {
+	if index < 0 {
+		return 0
+	}
+	if index >= len(f.offsets) {
+		return f.offsets[len(f.offsets)-1]
+	}
+	return f.offsets[index]
+}
+
+// Index returns the ANTLR index of the code point containing the byte offset.
+func (f *File) Index(offset int) int {
+	i := sort.SearchInts(f.offsets, offset)
+	if i < len(f.offsets) && f.offsets[i] == offset {
+		return i
+	}
+	// The offset is in the middle of a code point.
+	return i - 1
+}
+
+// Pos returns the token.Pos of the ANTLR index.
+func (f *File) Pos(index int) token.Pos {
+	return f.file.Pos(f.Offset(index))
+}
+
+// IndexOf returns the ANTLR index of the token.Pos, which must be in this file.
+func (f *File) IndexOf(pos token.Pos) int {
+	return f.Index(f.file.Offset(pos))
+}
+
+// TokenPos returns the position of the first character of the token.
+func (f *File) TokenPos(tok antlr.Token) token.Pos {
+	return f.Pos(tok.GetStart())
+}
+
+// TokenEnd returns the position immediately after the token, following the
+// convention of go/ast's End methods.
+func (f *File) TokenEnd(tok antlr.Token) token.Pos {
+	return f.Pos(tok.GetStop() + 1)
+}
+
+// NodePos returns the position of the first character of the node.
+func (f *File) NodePos(t antlr.ParseTree) token.Pos {
+	switch n := t.(type) {
+	case antlr.ParserRuleContext:
+		if n.GetStart() != nil {
+			return f.TokenPos(n.GetStart())
+		}
+	case antlr.TerminalNode:
+		return f.TokenPos(n.GetSymbol())
+	}
+	return token.NoPos
+}
+
+// NodeEnd returns the position immediately after the node.
+func (f *File) NodeEnd(t antlr.ParseTree) token.Pos {
+	switch n := t.(type) {
+	case antlr.ParserRuleContext:
+		start, stop := n.GetStart(), n.GetStop()
+		if stop == nil || start == nil || stop.GetTokenIndex() < start.GetTokenIndex() {
+			// Empty rules end where they start.
+			return f.NodePos(t)
+		}
+		return f.TokenEnd(stop)
+	case antlr.TerminalNode:
+		return f.TokenEnd(n.GetSymbol())
+	}
+	return token.NoPos
+}
@@ -0,0 +1,84 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gotoken
+
+import (
+	"go/token"
+	"testing"
+
+	"bramp.net/antlr4/json"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+func TestFile(t *testing.T) {
+	src := []byte("{\"ñ\": 1,\n \"€\": [\"😀\", 2]}")
+
+	fset := token.NewFileSet()
+	fset.AddFile("other.json", -1, 100) // So the file's base isn't 1
+	f := AddFile(fset, "test.json", src)
+
+	lexer := json.NewJSONLexer(antlr.NewInputStream(string(src)))
+	tests := []struct {
+		text       string
+		start, end string // Positions as formatted by go/token
+	}{
+		{`{`, "test.json:1:1", "test.json:1:2"},
+		{`"ñ"`, "test.json:1:2", "test.json:1:6"},
+		{`:`, "test.json:1:6", "test.json:1:7"},
+		{`1`, "test.json:1:8", "test.json:1:9"},
+		{`,`, "test.json:1:9", "test.json:1:10"},
+		{`"€"`, "test.json:2:2", "test.json:2:7"},
+		{`:`, "test.json:2:7", "test.json:2:8"},
+		{`[`, "test.json:2:9", "test.json:2:10"},
+		{`"😀"`, "test.json:2:10", "test.json:2:16"},
+		{`,`, "test.json:2:16", "test.json:2:17"},
+		{`2`, "test.json:2:18", "test.json:2:19"},
+		{`]`, "test.json:2:19", "test.json:2:20"},
+		{`}`, "test.json:2:20", "test.json:2:21"},
+	}
+
+	for _, test := range tests {
+		tok := lexer.NextToken()
+		if got := tok.GetText(); got != test.text {
+			t.Fatalf("NextToken() = %q, want %q", got, test.text)
+		}
+
+		pos, end := f.TokenPos(tok), f.TokenEnd(tok)
+		if got := fset.Position(pos).String(); got != test.start {
+			t.Errorf("TokenPos(%q) = %s, want %s", test.text, got, test.start)
+		}
+		if got := fset.Position(end).String(); got != test.end {
+			t.Errorf("TokenEnd(%q) = %s, want %s", test.text, got, test.end)
+		}
+
+		if got := f.IndexOf(pos); got != tok.GetStart() {
+			t.Errorf("IndexOf(TokenPos(%q)) = %d, want %d", test.text, got, tok.GetStart())
+		}
+		if got := string(src[f.file.Offset(pos):f.file.Offset(end)]); got != test.text {
+			t.Errorf("src[TokenPos(%q):TokenEnd(%q)] = %q, want %q", test.text, test.text, got, test.text)
+		}
+	}
+}
+
+func TestIndex(t *testing.T) {
+	f := AddFile(token.NewFileSet(), "test", []byte("a€b"))
+
+	// Byte offsets 1, 2 and 3 are all part of the €.
+	for offset, want := range []int{0, 1, 1, 1, 2, 3} {
+		if got := f.Index(offset); got != want {
+			t.Errorf("Index(%d) = %d, want %d", offset, got, want)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package grammars provides a registry of the pre-compiled grammars, and
+// helpers to lex and parse input without knowing the concrete Lexer or Parser
+// types at compile time.
+//
+// Each grammar package registers itself when imported, so to use the json
+// grammar via this package:
+//
+//	import _ "bramp.net/antlr4/json"
+//
+// or to make every grammar available:
+//
+//	import _ "bramp.net/antlr4/grammars/all"
+package grammars // import "bramp.net/antlr4/grammars"
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// Grammar describes one of the pre-compiled grammars.
+type Grammar struct {
+	Name     string // Name of the Go package, e.g "json"
+	LongName string // Name of the grammar as defined in the pom.xml, e.g "JSON"
+
+	// NewLexer returns a new Lexer for this grammar.
+	NewLexer func(input antlr.CharStream) antlr.Lexer
+
+	// NewParser returns a new Parser for this grammar, or is nil if this
+	// grammar only defines a Lexer.
+	NewParser func(input antlr.TokenStream) antlr.Parser
+
+	// EntryPoint is the name of the rule parsing should start from.
+	EntryPoint string
+
+	// Start invokes the EntryPoint rule on a Parser returned by NewParser.
+	Start func(parser antlr.Parser) antlr.ParserRuleContext
+
+	// RuleIndex returns the index of the parser rule with the given name, or
+	// false if there is none. It is nil if this grammar only defines a Lexer.
+	RuleIndex func(name string) (int, bool)
+
+	// TokenType returns the type of the token with the given symbolic name,
+	// e.g "STRING", or literal name, e.g "'{'", or false if there is none.
+	TokenType func(name string) (int, bool)
+
+	// CaseInsensitiveType is "UPPER" or "lower" if the lexer expects the
+	// input to be upper or lower cased, otherwise empty.
+	CaseInsensitiveType string
+
+	// Examples are the example inputs from grammars-v4, relative to the root
+	// of this repository, e.g "grammars-v4/json/examples/example1.json".
+	Examples []string
+
+	// Tier is how well tested the grammar is.
+	Tier Tier
+
+	// Flags are the package level variables read by the grammar's semantic
+	// predicates, such as ecmascript's strictMode. Use WithFlag to set them
+	// when parsing.
+	Flags map[string]*bool
+
+	// Licenses are the licenses of the grammar's g4 files.
+	Licenses []License
+}
+
+// HasParser returns true if this grammar defines a Parser.
+func (g *Grammar) HasParser() bool {
+	return g.NewParser != nil && g.Start != nil
+}
+
+// ImportPath returns the Go import path of the grammar's package.
+func (g *Grammar) ImportPath() string {
+	return "bramp.net/antlr4/" + g.Name
+}
+
+func (g *Grammar) String() string {
+	return g.Name
+}
+
+// NameIndexes maps each name to its index, preferring the first table, and
+// ignoring empty names. The generated packages use it to look up their rules
+// and tokens by name.
+func NameIndexes(tables ...[]string) map[string]int {
+	m := make(map[string]int)
+	for _, names := range tables {
+		for i, name := range names {
+			if _, found := m[name]; name != "" && !found {
+				m[name] = i
+			}
+		}
+	}
+	return m
+}
+
+var (
+	mu       sync.RWMutex
+	registry = make(map[string]*Grammar)
+)
+
+// Register makes a grammar available by its name. If Register is called
+// twice with the same name it panics.
+func Register(g *Grammar) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	if g == nil {
+		panic("grammars: Register grammar is nil")
+	}
+	if _, dup := registry[g.Name]; dup {
+		panic(fmt.Sprintf("grammars: Register called twice for %q", g.Name))
+	}
+	registry[g.Name] = g
+}
+
+// Lookup returns the registered grammar with the given name, or nil if
+// there is no such grammar.
+func Lookup(name string) *Grammar {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	return registry[name]
+}
+
+// All returns all the registered grammars sorted by name.
+func All() []*Grammar {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	var all []*Grammar
+	for _, g := range registry {
+		all = append(all, g)
+	}
+	sort.Slice(all, func(i, j int) bool {
+		return all[i].Name < all[j].Name
+	})
+	return all
+}
+
+// ByExtension returns the registered grammars, sorted by name, with an
+// example input with the file extension, e.g ".json". The extension is case
+// insensitive.
+func ByExtension(ext string) []*Grammar {
+	ext = strings.ToLower(ext)
+	if ext == "" || ext == "." {
+		return nil
+	}
+
+	var found []*Grammar
+	for _, g := range All() {
+		for _, example := range g.Examples {
+			if strings.ToLower(path.Ext(example)) == ext {
+				found = append(found, g)
+				break
+			}
+		}
+	}
+	return found
+}
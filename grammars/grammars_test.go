@@ -0,0 +1,213 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grammars_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"bramp.net/antlr4/grammars"
+	"bramp.net/antlr4/json"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/kylelemons/godebug/pretty"
+)
+
+func TestLookup(t *testing.T) {
+	g := grammars.Lookup("json")
+	if g == nil {
+		t.Fatalf("Lookup(%q) = nil, want the json grammar", "json")
+	}
+	if !g.HasParser() {
+		t.Errorf("Lookup(%q).HasParser() = false, want true", "json")
+	}
+
+	if got := grammars.Lookup("does-not-exist"); got != nil {
+		t.Errorf("Lookup(%q) = %v, want nil", "does-not-exist", got)
+	}
+
+	found := false
+	for _, g := range grammars.All() {
+		found = found || g.Name == "json"
+	}
+	if !found {
+		t.Errorf("All() did not contain the json grammar")
+	}
+}
+
+func TestNameIndexes(t *testing.T) {
+	g := grammars.Lookup("json")
+
+	rules := []struct {
+		name string
+		want int
+		ok   bool
+	}{
+		{"json", json.JSONParserRULE_json, true},
+		{"obj", json.JSONParserRULE_obj, true},
+		{"STRING", 0, false}, // A token, not a rule
+		{"", 0, false},
+	}
+	for _, test := range rules {
+		if got, ok := g.RuleIndex(test.name); got != test.want || ok != test.ok {
+			t.Errorf("RuleIndex(%q) = %d, %t, want %d, %t", test.name, got, ok, test.want, test.ok)
+		}
+	}
+
+	tokens := []struct {
+		name string
+		want int
+		ok   bool
+	}{
+		{"STRING", json.JSONParserSTRING, true},
+		{"'{'", json.JSONParserT__0, true},
+		{"{", 0, false}, // Literal names include their quotes
+		{"json", 0, false},
+		{"", 0, false},
+	}
+	for _, test := range tokens {
+		if got, ok := g.TokenType(test.name); got != test.want || ok != test.ok {
+			t.Errorf("TokenType(%q) = %d, %t, want %d, %t", test.name, got, ok, test.want, test.ok)
+		}
+	}
+}
+
+func TestRegisterTwice(t *testing.T) {
+	defer func() {
+		if recover() == nil {
+			t.Errorf("Register(json) did not panic, want panic as json is already registered")
+		}
+	}()
+	grammars.Register(&grammars.Grammar{Name: "json"})
+}
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		input  string
+		errors int
+	}{
+		{`{"a": [1, 2, 3]}`, 0},
+		{`{"a": [1, 2, 3}`, 1},
+	}
+
+	g := grammars.Lookup("json")
+	for _, test := range tests {
+		result, err := g.Parse(antlr.NewInputStream(test.input))
+		if err != nil {
+			t.Errorf("Parse(%q) err = %s, want nil", test.input, err)
+			continue
+		}
+
+		if got := len(result.Errors); got != test.errors {
+			t.Errorf("Parse(%q) got %d errors, want %d: %v", test.input, got, test.errors, result.Errors)
+		}
+		if result.Tree == nil {
+			t.Errorf("Parse(%q).Tree = nil, want a tree", test.input)
+		}
+	}
+}
+
+func TestStats(t *testing.T) {
+	got := grammars.Lookup("json").Stats()
+
+	if got.LexerRules != 18 || got.ParserRules != 5 || got.Tokens != 12 || got.LexerModes != 1 {
+		t.Errorf("Stats(json) = %+v, want 18 lexer rules, 5 parser rules, 12 tokens and 1 mode", got)
+	}
+	if got.LexerStates == 0 || got.ParserStates == 0 || got.ParserDecisions == 0 {
+		t.Errorf("Stats(json) = %+v, want non-zero ATN states and decisions", got)
+	}
+}
+
+func TestTokenize(t *testing.T) {
+	tests := []struct {
+		input  string
+		tokens int
+		errors int
+	}{
+		{`{"a": 1}`, 5, 0},
+		{`{"a": ~1}`, 5, 1},
+	}
+
+	g := grammars.Lookup("json")
+	for _, test := range tests {
+		tokens, errors := g.Tokenize(antlr.NewInputStream(test.input))
+		if len(tokens) != test.tokens || len(errors) != test.errors {
+			t.Errorf("Tokenize(%q) = %d tokens, %d errors, want %d tokens, %d errors", test.input, len(tokens), len(errors), test.tokens, test.errors)
+		}
+	}
+}
+
+type testTracer struct {
+	grammar              string
+	size, tokens, errors int
+	started, ended       bool
+}
+
+func (t *testTracer) StartParse(ctx context.Context, g *grammars.Grammar, inputSize int) func(tokens, errors int) {
+	t.started, t.grammar, t.size = true, g.Name, inputSize
+	return func(tokens, errors int) {
+		t.ended, t.tokens, t.errors = true, tokens, errors
+	}
+}
+
+func TestParseTracer(t *testing.T) {
+	const input = `{"a": [1, 2}`
+
+	tracer := &testTracer{}
+	if _, err := grammars.Lookup("json").Parse(antlr.NewInputStream(input), grammars.WithTracer(tracer)); err != nil {
+		t.Fatalf("Parse(%q) err = %s, want nil", input, err)
+	}
+
+	// The parser may, or may not have read the final EOF token.
+	if tracer.tokens < 9 || tracer.tokens > 10 {
+		t.Errorf("Parse(%q, WithTracer(...)) traced %d tokens, want 9 or 10", input, tracer.tokens)
+	}
+	tracer.tokens = 0
+
+	want := &testTracer{grammar: "json", size: len(input), errors: 1, started: true, ended: true}
+	if diff := pretty.Compare(tracer, want); diff != "" {
+		t.Errorf("Parse(%q, WithTracer(...)) diff: (-got +want)\n%s", input, diff)
+	}
+}
+
+type testMetrics struct {
+	parses, errors, states int
+}
+
+func (m *testMetrics) ObserveParse(g *grammars.Grammar, duration time.Duration, errors int) {
+	m.parses++
+	m.errors += errors
+}
+
+func (m *testMetrics) ObserveCacheSize(g *grammars.Grammar, states int) {
+	m.states = states
+}
+
+func TestParseMetrics(t *testing.T) {
+	g := grammars.Lookup("json")
+	metrics := &testMetrics{}
+	for _, input := range []string{`{"a": [1, 2]}`, `{"a": [1, 2}`} {
+		if _, err := g.Parse(antlr.NewInputStream(input), grammars.WithMetrics(metrics)); err != nil {
+			t.Fatalf("Parse(%q) err = %s, want nil", input, err)
+		}
+	}
+
+	if metrics.parses != 2 || metrics.errors != 1 {
+		t.Errorf("Parse(...) counted %d parses with %d errors, want 2 with 1", metrics.parses, metrics.errors)
+	}
+	if metrics.states <= 0 {
+		t.Errorf("Parse(...) reported %d DFA states, want > 0", metrics.states)
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grammars
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+)
+
+// IndexFilename is the name of the index, at the root of this repository.
+const IndexFilename = "grammars.json"
+
+// Index is a catalog of every grammar make tried to generate, including
+// those that failed. It is written to grammars.json alongside
+// grammars/all, so tools not written in Go can find the grammars without
+// importing their packages.
+type Index struct {
+	ANTLRVersion string `json:"antlr_version"` // e.g "4.7.2"
+	GrammarsV4   string `json:"grammars_v4"`   // Commit of grammars-v4 the grammars were generated from
+
+	// PassRate is the fraction of Grammars that passed their tests.
+	PassRate float64 `json:"pass_rate"`
+
+	Grammars []*IndexEntry `json:"grammars"` // Sorted by name
+}
+
+// IndexEntry describes one grammar in the Index.
+type IndexEntry struct {
+	Name       string `json:"name"`      // Name of the Go package, e.g "json"
+	LongName   string `json:"long_name"` // e.g "JSON"
+	ImportPath string `json:"import_path"`
+
+	// Pom and Files are the pom.xml, and g4 files, the grammar is generated
+	// from, relative to the root of this repository.
+	Pom   string   `json:"pom"`
+	Files []string `json:"files"`
+
+	EntryPoint          string   `json:"entry_point,omitempty"`
+	CaseInsensitiveType string   `json:"case_insensitive_type,omitempty"`
+	Examples            []string `json:"examples,omitempty"`
+	Extensions          []string `json:"extensions,omitempty"` // Of the examples, e.g ".json"
+
+	// Passed is true if the grammar was generated, and passed its tests, and
+	// is therefore registered by grammars/all.
+	Passed bool `json:"passed"`
+
+	// Tier is the tier it's registered with, recorded from its test results,
+	// or nil if it didn't pass.
+	Tier *Tier `json:"tier,omitempty"`
+}
+
+// ReadIndex reads an Index in the format of grammars.json.
+func ReadIndex(r io.Reader) (*Index, error) {
+	idx := &Index{}
+	if err := json.NewDecoder(r).Decode(idx); err != nil {
+		return nil, err
+	}
+	return idx, nil
+}
+
+// ReadIndexFile reads an Index from the named file, e.g grammars.json.
+func ReadIndexFile(filename string) (*Index, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return ReadIndex(f)
+}
+
+// Lookup returns the entry for the named grammar, or nil if there is no such
+// grammar.
+func (idx *Index) Lookup(name string) *IndexEntry {
+	for _, e := range idx.Grammars {
+		if e.Name == name {
+			return e
+		}
+	}
+	return nil
+}
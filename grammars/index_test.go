@@ -0,0 +1,59 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grammars_test
+
+import (
+	"strings"
+	"testing"
+
+	"bramp.net/antlr4/grammars"
+)
+
+func TestReadIndex(t *testing.T) {
+	idx, err := grammars.ReadIndex(strings.NewReader(`{
+		"antlr_version": "4.7.2",
+		"pass_rate": 0.5,
+		"grammars": [
+			{"name": "json", "long_name": "JSON", "entry_point": "json", "extensions": [".json"], "passed": true, "tier": "flaky"},
+			{"name": "broken", "passed": false}
+		]
+	}`))
+	if err != nil {
+		t.Fatalf("ReadIndex(...) err = %s, want nil", err)
+	}
+
+	if got, want := idx.ANTLRVersion, "4.7.2"; got != want {
+		t.Errorf("ReadIndex(...).ANTLRVersion = %q, want %q", got, want)
+	}
+
+	e := idx.Lookup("json")
+	if e == nil {
+		t.Fatalf("Lookup(%q) = nil, want entry", "json")
+	}
+	if !e.Passed || e.EntryPoint != "json" || e.LongName != "JSON" {
+		t.Errorf("Lookup(%q) = %+v, want the json entry", "json", e)
+	}
+
+	if e.Tier == nil || *e.Tier != grammars.Flaky {
+		t.Errorf("Lookup(%q).Tier = %v, want %s", "json", e.Tier, grammars.Flaky)
+	}
+	if e := idx.Lookup("broken"); e == nil || e.Tier != nil {
+		t.Errorf("Lookup(%q) = %+v, want no tier as it failed", "broken", e)
+	}
+
+	if got := idx.Lookup("missing"); got != nil {
+		t.Errorf("Lookup(%q) = %+v, want nil", "missing", got)
+	}
+}
@@ -0,0 +1,240 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package interp loads a grammar from its .g4 files at runtime, so changes to
+// a grammar can be tried out without regenerating and recompiling this
+// repository.
+//
+// The ANTLR tool is run to turn the .g4 files into a serialized ATN, which is
+// then interpreted by the Go runtime. This requires Java and the ANTLR jar,
+// the same as "make" does.
+//
+// The Go runtime (unlike the Java runtime) has no ParserInterpreter, and
+// the parser's rules are generated as Go code, so only the lexer is
+// interpreted. The returned Grammar has no parser, but works with everything
+// that only needs tokens, such as Grammar.Tokenize, tokenclass and the
+// highlighters.
+package interp // import "bramp.net/antlr4/grammars/interp"
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"bramp.net/antlr4/grammars"
+	"bramp.net/antlr4/internal"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// Tool is the ANTLR tool used to generate the ATN.
+type Tool struct {
+	Java string // Path to java, e.g "java"
+	Jar  string // Path to the ANTLR complete jar
+}
+
+// DefaultTool uses java from the PATH, and the ANTLR jar from $ANTLR_JAR, or
+// where the Makefile expects it.
+func DefaultTool() *Tool {
+	jar := os.Getenv("ANTLR_JAR")
+	if jar == "" {
+		jar = internal.ANTLRJar()
+	}
+	return &Tool{
+		Java: "java",
+		Jar:  jar,
+	}
+}
+
+// Load returns a Grammar with a lexer interpreted from the g4 files, using
+// the DefaultTool.
+func Load(filenames ...string) (*grammars.Grammar, error) {
+	return DefaultTool().Load(filenames...)
+}
+
+// Load returns a Grammar with a lexer interpreted from the g4 files. The
+// files must be in the same directory, and define a lexer, either as a
+// lexer or combined grammar.
+func (t *Tool) Load(filenames ...string) (*grammars.Grammar, error) {
+	if len(filenames) == 0 {
+		return nil, fmt.Errorf("no .g4 files given")
+	}
+
+	// The tool is run from the grammar's directory, so the generated files
+	// aren't placed in sub-directories of the output.
+	dir := filepath.Dir(filenames[0])
+	args := []string{"-jar", t.Jar, "-Dlanguage=Go", "-no-listener", "-no-visitor", "-package", "interp"}
+	for _, filename := range filenames {
+		if filepath.Dir(filename) != dir {
+			return nil, fmt.Errorf("all the .g4 files must be in the same directory, %q is not in %q", filename, dir)
+		}
+		args = append(args, filepath.Base(filename))
+	}
+
+	out, err := ioutil.TempDir("", "interp")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(out)
+
+	var stderr bytes.Buffer
+	cmd := exec.Command(t.Java, append(args, "-o", out)...)
+	cmd.Dir = dir
+	cmd.Stdout = &stderr
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		return nil, fmt.Errorf("antlr failed: %s\n%s", err, stderr.String())
+	}
+
+	lexers, err := filepath.Glob(filepath.Join(out, "*_lexer.go"))
+	if err != nil {
+		return nil, err
+	}
+	if len(lexers) != 1 {
+		return nil, fmt.Errorf("found %d lexers, want 1", len(lexers))
+	}
+
+	src, err := ioutil.ReadFile(lexers[0])
+	if err != nil {
+		return nil, err
+	}
+	l, err := parseLexer(src)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", filepath.Base(lexers[0]), err)
+	}
+	return l.grammar(), nil
+}
+
+// lexerData is the data from a generated lexer, needed to interpret it.
+type lexerData struct {
+	GrammarFileName string // e.g "JSON.g4"
+
+	SerializedATN []uint16
+	LiteralNames  []string
+	SymbolicNames []string
+	RuleNames     []string
+}
+
+// parseLexer extracts the lexerData from the source of a generated lexer.
+func parseLexer(src []byte) (*lexerData, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), "", src, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &lexerData{}
+	ast.Inspect(f, func(n ast.Node) bool {
+		switch n := n.(type) {
+		case *ast.ValueSpec:
+			if len(n.Names) != 1 || len(n.Values) != 1 {
+				break
+			}
+			lit, ok := n.Values[0].(*ast.CompositeLit)
+			if !ok {
+				break
+			}
+			switch n.Names[0].Name {
+			case "serializedLexerAtn":
+				for _, elt := range lit.Elts {
+					if v, err := strconv.ParseUint(basicLit(elt), 0, 16); err == nil {
+						l.SerializedATN = append(l.SerializedATN, uint16(v))
+					}
+				}
+			case "lexerLiteralNames":
+				l.LiteralNames = stringLits(lit)
+			case "lexerSymbolicNames":
+				l.SymbolicNames = stringLits(lit)
+			case "lexerRuleNames":
+				l.RuleNames = stringLits(lit)
+			}
+
+		case *ast.AssignStmt:
+			// l.GrammarFileName = "JSON.g4"
+			if sel, ok := n.Lhs[0].(*ast.SelectorExpr); ok && sel.Sel.Name == "GrammarFileName" && len(n.Rhs) == 1 {
+				l.GrammarFileName, _ = strconv.Unquote(basicLit(n.Rhs[0]))
+			}
+		}
+		return true
+	})
+
+	if len(l.SerializedATN) == 0 {
+		return nil, fmt.Errorf("failed to find the serialized ATN")
+	}
+	return l, nil
+}
+
+func basicLit(expr ast.Expr) string {
+	if lit, ok := expr.(*ast.BasicLit); ok {
+		return lit.Value
+	}
+	return ""
+}
+
+func stringLits(lit *ast.CompositeLit) []string {
+	var strs []string
+	for _, elt := range lit.Elts {
+		s, _ := strconv.Unquote(basicLit(elt))
+		strs = append(strs, s)
+	}
+	return strs
+}
+
+// grammar returns a Grammar which interprets the lexer.
+func (l *lexerData) grammar() *grammars.Grammar {
+	atn := antlr.NewATNDeserializer(nil).DeserializeFromUInt16(l.SerializedATN)
+	decisionToDFA := make([]*antlr.DFA, len(atn.DecisionToState))
+	for index, ds := range atn.DecisionToState {
+		decisionToDFA[index] = antlr.NewDFA(ds, index)
+	}
+
+	name := strings.TrimSuffix(l.GrammarFileName, ".g4")
+	return &grammars.Grammar{
+		Name:     strings.ToLower(name),
+		LongName: name,
+
+		NewLexer: func(input antlr.CharStream) antlr.Lexer {
+			lexer := &interpLexer{
+				BaseLexer: antlr.NewBaseLexer(input),
+			}
+			lexer.Interpreter = antlr.NewLexerATNSimulator(lexer, atn, decisionToDFA, antlr.NewPredictionContextCache())
+			lexer.RuleNames = l.RuleNames
+			lexer.LiteralNames = l.LiteralNames
+			lexer.SymbolicNames = l.SymbolicNames
+			lexer.GrammarFileName = l.GrammarFileName
+			return lexer
+		},
+	}
+}
+
+// interpLexer is a lexer driven only by its ATN, the same as a generated
+// lexer, but without any of the grammar's actions or predicates.
+type interpLexer struct {
+	*antlr.BaseLexer
+}
+
+// Action ignores the grammar's actions, which are written in the target
+// language and can't be interpreted.
+func (l *interpLexer) Action(localctx antlr.RuleContext, ruleIndex, actionIndex int) {}
+
+// Sempred treats all of the grammar's predicates as true.
+func (l *interpLexer) Sempred(localctx antlr.RuleContext, ruleIndex, predIndex int) bool {
+	return true
+}
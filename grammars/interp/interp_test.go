@@ -0,0 +1,54 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interp
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+)
+
+func TestParseLexer(t *testing.T) {
+	// The generated json lexer is the same as the one generated by Load.
+	src, err := ioutil.ReadFile("../../json/json_lexer.go")
+	if err != nil {
+		t.Fatalf("ReadFile(...) err = %s, want nil", err)
+	}
+
+	l, err := parseLexer(src)
+	if err != nil {
+		t.Fatalf("parseLexer(...) err = %s, want nil", err)
+	}
+
+	if got, want := l.GrammarFileName, "JSON.g4"; got != want {
+		t.Errorf("parseLexer(...).GrammarFileName = %q, want %q", got, want)
+	}
+	if diff := pretty.Compare(l.SerializedATN[:3], []uint16{3, 24715, 42794}); diff != "" {
+		t.Errorf("parseLexer(...).SerializedATN[:3] diff: (-got +want)\n%s", diff)
+	}
+
+	got := l.SymbolicNames
+	want := []string{"", "", "", "", "", "", "", "", "", "", "STRING", "NUMBER", "WS"}
+	if diff := pretty.Compare(got, want); diff != "" {
+		t.Errorf("parseLexer(...).SymbolicNames diff: (-got +want)\n%s", diff)
+	}
+}
+
+func TestParseLexerMissingATN(t *testing.T) {
+	if _, err := parseLexer([]byte("package interp\n")); err == nil {
+		t.Errorf("parseLexer(...) err = nil, want error")
+	}
+}
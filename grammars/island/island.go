@@ -0,0 +1,250 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package island parses languages embedded in another, such as SQL inside a
+// Java string literal, or JavaScript inside a HTML script element. The host
+// is parsed first, then each of its tokens containing an island is parsed
+// again with the island's grammar:
+//
+//	result, err := island.Parse(grammars.Lookup("java"), input, []island.Island{{
+//		Token:   "StringLiteral",
+//		Grammar: grammars.Lookup("sqlite"),
+//		Trim:    island.Quoted,
+//	}})
+//
+// The islands' syntax errors are reported at their position in the input,
+// and Walk and Children visit each island's parse tree as if it were the
+// child of the token containing it.
+package island // import "bramp.net/antlr4/grammars/island"
+
+import (
+	"fmt"
+	"sort"
+
+	"bramp.net/antlr4/grammars"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// Island describes which of the host's tokens are in another language.
+type Island struct {
+	// Token is the symbolic name of the host's token containing the island,
+	// e.g "STRING". The token must be in the host's parse tree, so can not
+	// be on a hidden channel.
+	Token string
+
+	// Grammar parses the island.
+	Grammar *grammars.Grammar
+
+	// Trim returns the number of runes to remove from the left and right of
+	// the token's text, such as its quotes, or false if the token is not an
+	// island. If nil the whole token is parsed.
+	Trim func(text string) (left, right int, ok bool)
+
+	// Options are passed to Parse, for example WithStartRule to parse a
+	// single expression, or WithPreprocessor to unescape a string literal.
+	Options []grammars.Option
+}
+
+// Quoted is a Trim removing a pair of matching single, double or back
+// quotes. It does not unescape the text in between.
+func Quoted(text string) (left, right int, ok bool) {
+	r := []rune(text)
+	if len(r) < 2 || r[0] != r[len(r)-1] {
+		return 0, 0, false
+	}
+	switch r[0] {
+	case '\'', '"', '`':
+		return 1, 1, true
+	}
+	return 0, 0, false
+}
+
+// Embedded is a island found in the host.
+type Embedded struct {
+	Island *Island
+
+	// Node is the host's token containing the island.
+	Node antlr.TerminalNode
+
+	// Span is the island's position in the input.
+	Span grammars.Span
+
+	// Result is the island's parse. Its SourceMap maps the island's tokens'
+	// offsets back to the input.
+	Result *grammars.Result
+}
+
+// Result is the output of parsing the host, and its islands.
+type Result struct {
+	Host    *grammars.Result
+	Islands []*Embedded // In the order they appear in the input
+
+	byToken map[int]*Embedded
+}
+
+// Parse parses the input with the host grammar, and then each of its tokens
+// that match one of the islands. The tokens are matched against the islands
+// in order, and only the first match is parsed. The opts are only used to
+// parse the host.
+func Parse(host *grammars.Grammar, input antlr.CharStream, islands []Island, opts ...grammars.Option) (*Result, error) {
+	types := make(map[int][]*Island)
+	for i := range islands {
+		island := &islands[i]
+		if island.Grammar == nil {
+			return nil, fmt.Errorf("%s: island in token %q has no grammar", host.Name, island.Token)
+		}
+		t, found := host.TokenType(island.Token)
+		if !found {
+			return nil, fmt.Errorf("%s: unknown token %q", host.Name, island.Token)
+		}
+		types[t] = append(types[t], island)
+	}
+
+	hostResult, err := host.Parse(input, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{
+		Host:    hostResult,
+		byToken: make(map[int]*Embedded),
+	}
+
+	var walk func(t antlr.Tree) error
+	walk = func(t antlr.Tree) error {
+		if node, ok := t.(antlr.TerminalNode); ok {
+			if _, isError := t.(antlr.ErrorNode); isError {
+				return nil
+			}
+			return result.parseIsland(input, node, types[node.GetSymbol().GetTokenType()])
+		}
+		for _, c := range t.GetChildren() {
+			if err := walk(c); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := walk(hostResult.Tree); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// parseIsland parses the node with the first of the islands it matches.
+func (r *Result) parseIsland(input antlr.CharStream, node antlr.TerminalNode, islands []*Island) error {
+	token := node.GetSymbol()
+	if token.GetStart() < 0 || token.GetTokenIndex() < 0 {
+		// Such as a token conjured up by the parser's error recovery.
+		return nil
+	}
+
+	for _, island := range islands {
+		left, right, ok := 0, 0, true
+		if island.Trim != nil {
+			left, right, ok = island.Trim(token.GetText())
+		}
+		if !ok {
+			continue
+		}
+
+		// The token's offsets are in the host's preprocessed input, if any.
+		m := r.Host.SourceMap
+		span := grammars.Span{
+			Start: m.Original(token.GetStart()) + left,
+			Stop:  m.Original(token.GetStop()) - right,
+		}
+		if span.Stop < span.Start-1 {
+			continue
+		}
+
+		opts := append([]grammars.Option{grammars.WithSpan(span)}, island.Options...)
+		result, err := island.Grammar.Parse(input, opts...)
+		if err != nil {
+			return err
+		}
+
+		e := &Embedded{
+			Island: island,
+			Node:   node,
+			Span:   span,
+			Result: result,
+		}
+		r.Islands = append(r.Islands, e)
+		r.byToken[token.GetTokenIndex()] = e
+		return nil
+	}
+	return nil
+}
+
+// Island returns the island contained in the host's token, or nil if there is
+// none.
+func (r *Result) Island(node antlr.TerminalNode) *Embedded {
+	// The islands' tokens have indexes too, so check it is the host's.
+	if e := r.byToken[node.GetSymbol().GetTokenIndex()]; e != nil && e.Node == node {
+		return e
+	}
+	return nil
+}
+
+// Children returns the node's children in the composed tree. That is the
+// island's parse tree for a host token containing a island, otherwise the
+// node's own children.
+func (r *Result) Children(t antlr.Tree) []antlr.Tree {
+	if node, ok := t.(antlr.TerminalNode); ok {
+		if e := r.Island(node); e != nil && e.Result.Tree != nil {
+			return []antlr.Tree{e.Result.Tree}
+		}
+	}
+	return t.GetChildren()
+}
+
+// Walk calls fn for every node in the composed tree, depth first, starting at
+// the host's root. The island is the island the node belongs to, or nil for
+// the host's nodes. If fn returns false, the node's children are skipped.
+func (r *Result) Walk(fn func(t antlr.Tree, island *Embedded) bool) {
+	var walk func(t antlr.Tree, island *Embedded)
+	walk = func(t antlr.Tree, island *Embedded) {
+		if !fn(t, island) {
+			return
+		}
+		if node, ok := t.(antlr.TerminalNode); ok {
+			if e := r.Island(node); e != nil && e.Result.Tree != nil {
+				walk(e.Result.Tree, e)
+				return
+			}
+		}
+		for _, c := range t.GetChildren() {
+			walk(c, island)
+		}
+	}
+	walk(r.Host.Tree, nil)
+}
+
+// Errors returns the syntax errors found in the host and its islands, sorted
+// by their position in the input.
+func (r *Result) Errors() []*grammars.SyntaxError {
+	errors := append([]*grammars.SyntaxError(nil), r.Host.Errors...)
+	for _, e := range r.Islands {
+		errors = append(errors, e.Result.Errors...)
+	}
+	sort.SliceStable(errors, func(i, j int) bool {
+		if errors[i].Line != errors[j].Line {
+			return errors[i].Line < errors[j].Line
+		}
+		return errors[i].Column < errors[j].Column
+	})
+	return errors
+}
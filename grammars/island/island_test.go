@@ -0,0 +1,116 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package island
+
+import (
+	"strings"
+	"testing"
+
+	"bramp.net/antlr4/grammars"
+	_ "bramp.net/antlr4/json"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+func TestQuoted(t *testing.T) {
+	tests := []struct {
+		text        string
+		left, right int
+		ok          bool
+	}{
+		{`"abc"`, 1, 1, true},
+		{`'abc'`, 1, 1, true},
+		{"`abc`", 1, 1, true},
+		{`""`, 1, 1, true},
+		{`"abc'`, 0, 0, false},
+		{`abc`, 0, 0, false},
+		{`"`, 0, 0, false},
+	}
+
+	for _, test := range tests {
+		left, right, ok := Quoted(test.text)
+		if left != test.left || right != test.right || ok != test.ok {
+			t.Errorf("Quoted(%q) = %d, %d, %t, want %d, %d, %t", test.text, left, right, ok, test.left, test.right, test.ok)
+		}
+	}
+}
+
+// jsonInJSON is JSON embedded in the JSON strings starting with "[".
+func jsonInJSON() []Island {
+	return []Island{{
+		Token:   "STRING",
+		Grammar: grammars.Lookup("json"),
+		Trim: func(text string) (left, right int, ok bool) {
+			if !strings.HasPrefix(text, `"[`) {
+				return 0, 0, false
+			}
+			return Quoted(text)
+		},
+		Options: []grammars.Option{grammars.WithStartRule("array")},
+	}}
+}
+
+func TestParse(t *testing.T) {
+	g := grammars.Lookup("json")
+
+	input := grammars.NewNamedStream(antlr.NewInputStream("{\"a\": \"[1, 2]\",\n \"b\": \"[3, }\"}"), "a.json", "")
+	result, err := Parse(g, input, jsonInJSON())
+	if err != nil {
+		t.Fatalf("Parse(...) = %s", err)
+	}
+
+	if got, want := len(result.Islands), 2; got != want {
+		t.Fatalf("Parse(...) got %d islands, want %d", got, want)
+	}
+	if got, want := result.Islands[0].Span, (grammars.Span{Start: 7, Stop: 12}); got != want {
+		t.Errorf("Parse(...).Islands[0].Span = %v, want %v", got, want)
+	}
+	if island := result.Island(result.Islands[0].Node); island != result.Islands[0] {
+		t.Errorf("Island(%q) = %v, want %v", result.Islands[0].Node.GetText(), island, result.Islands[0])
+	}
+
+	errors := result.Errors()
+	if len(errors) == 0 {
+		t.Fatalf("Parse(...).Errors() = %v, want an error", errors)
+	}
+	if got, want := errors[0].Error(), "a.json:2:11"; !strings.HasPrefix(got, want) {
+		t.Errorf("Parse(...).Errors()[0] = %q, want prefix %q", got, want)
+	}
+
+	// The islands' numbers are found by walking the composed tree.
+	var numbers []string
+	result.Walk(func(t antlr.Tree, island *Embedded) bool {
+		if node, ok := t.(antlr.TerminalNode); ok && island != nil {
+			if text := node.GetText(); text >= "0" && text <= "9" {
+				numbers = append(numbers, text)
+			}
+		}
+		return true
+	})
+	if got, want := strings.Join(numbers, ","), "1,2,3"; got != want {
+		t.Errorf("Walk(...) found numbers %q, want %q", got, want)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	g := grammars.Lookup("json")
+	input := antlr.NewInputStream("{}")
+
+	if _, err := Parse(g, input, []Island{{Token: "MISSING", Grammar: g}}); err == nil {
+		t.Errorf("Parse(..., Token: %q) = nil, want error", "MISSING")
+	}
+	if _, err := Parse(g, input, []Island{{Token: "STRING"}}); err == nil {
+		t.Errorf("Parse(..., Grammar: nil) = nil, want error")
+	}
+}
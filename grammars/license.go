@@ -0,0 +1,46 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grammars
+
+import "sort"
+
+// License is the license of one of a grammar's g4 files, taken from the
+// file's header comment when the grammar was generated.
+type License struct {
+	Grammar  string // Name of the grammar, set by Licenses
+	Filename string // The g4 file, e.g "grammars-v4/json/JSON.g4"
+	SPDX     string // SPDX identifier, e.g "MIT", or empty if not recognised
+	Text     string // Full text of the license
+}
+
+// Licenses returns the licenses of all the registered grammars, sorted by
+// grammar then filename, for producing third-party notices. Grammars whose
+// g4 files have no license in their header are omitted.
+func Licenses() []License {
+	var licenses []License
+	for _, g := range All() {
+		for _, l := range g.Licenses {
+			l.Grammar = g.Name
+			licenses = append(licenses, l)
+		}
+	}
+	sort.SliceStable(licenses, func(i, j int) bool {
+		if licenses[i].Grammar != licenses[j].Grammar {
+			return licenses[i].Grammar < licenses[j].Grammar
+		}
+		return licenses[i].Filename < licenses[j].Filename
+	})
+	return licenses
+}
@@ -0,0 +1,47 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grammars_test
+
+import (
+	"testing"
+
+	"bramp.net/antlr4/grammars"
+	"github.com/kylelemons/godebug/pretty"
+)
+
+func TestLicenses(t *testing.T) {
+	grammars.Register(&grammars.Grammar{
+		Name: "licensed",
+		Licenses: []grammars.License{
+			{Filename: "grammars-v4/licensed/LicensedParser.g4", SPDX: "MIT", Text: "The MIT License"},
+			{Filename: "grammars-v4/licensed/LicensedLexer.g4", SPDX: "BSD-3-Clause", Text: "The BSD License"},
+		},
+	})
+
+	var got []grammars.License
+	for _, l := range grammars.Licenses() {
+		if l.Grammar == "licensed" {
+			got = append(got, l)
+		}
+	}
+
+	want := []grammars.License{
+		{Grammar: "licensed", Filename: "grammars-v4/licensed/LicensedLexer.g4", SPDX: "BSD-3-Clause", Text: "The BSD License"},
+		{Grammar: "licensed", Filename: "grammars-v4/licensed/LicensedParser.g4", SPDX: "MIT", Text: "The MIT License"},
+	}
+	if diff := pretty.Compare(got, want); diff != "" {
+		t.Errorf("Licenses() diff: (-got +want)\n%s", diff)
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.17
+// +build go1.17
+
+package linguist_test
+
+import (
+	"testing"
+
+	_ "bramp.net/antlr4/dot"
+	"bramp.net/antlr4/grammars"
+	"bramp.net/antlr4/grammars/linguist"
+	_ "bramp.net/antlr4/restructuredtext"
+	_ "bramp.net/antlr4/rpn"
+)
+
+func TestGrammar(t *testing.T) {
+	tests := []struct {
+		language string
+		want     string
+	}{
+		{"JSON", "json"},          // Named after the lower cased language
+		{"Graphviz (DOT)", "dot"}, // Mapped by Languages
+		{"reStructuredText", "restructuredtext"},
+		{"Text", ""}, // No grammar
+		{"", ""},     // enry.OtherLanguage
+	}
+
+	for _, test := range tests {
+		got := ""
+		if g := linguist.Grammar(test.language); g != nil {
+			got = g.Name
+		}
+		if got != test.want {
+			t.Errorf("Grammar(%q) = %q, want %q", test.language, got, test.want)
+		}
+	}
+}
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		filename string
+		content  string
+		want     string
+	}{
+		{"example.json", `{"a": [1, 2]}`, "json"},
+		{"graph.dot", `digraph G { a -> b; }`, "dot"},
+		{"README.rst", "Title\n=====\n\nSome text.\n", "restructuredtext"},
+
+		// Text has no grammar, so the grammars with .txt examples are sniffed.
+		{"sum.txt", "3 4 +", "rpn"},
+		{"sum.txt", `{"a": 1}`, ""},
+
+		{"example.unknown", `{"a": 1}`, ""},
+	}
+
+	for _, test := range tests {
+		got := ""
+		if g := linguist.Detect(test.filename, []byte(test.content)); g != nil {
+			got = g.Name
+		}
+		if got != test.want {
+			t.Errorf("Detect(%q, %q) = %q, want %q", test.filename, test.content, got, test.want)
+		}
+	}
+}
+
+func TestDetectTier(t *testing.T) {
+	// restructuredtext has no examples, so is Untested.
+	const filename, content = "README.rst", "Title\n=====\n\nSome text.\n"
+
+	tests := []struct {
+		min  grammars.Tier
+		want string
+	}{
+		{grammars.Untested, "restructuredtext"},
+		{grammars.Stable, ""},
+	}
+
+	for _, test := range tests {
+		got := ""
+		if g := linguist.DetectTier(filename, []byte(content), test.min); g != nil {
+			got = g.Name
+		}
+		if got != test.want {
+			t.Errorf("DetectTier(%q, %q, %s) = %q, want %q", filename, content, test.min, got, test.want)
+		}
+	}
+
+	if g := linguist.DetectTier("graph.dot", []byte(`digraph G { a -> b; }`), grammars.Stable); g == nil || g.Name != "dot" {
+		t.Errorf("DetectTier(%q, ..., %s) = %v, want dot", "graph.dot", grammars.Stable, g)
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package linguist routes files to the right grammar, so tools scanning a
+// repository can parse each file without being told its language.
+//
+// Detect uses go-enry, a port of GitHub's linguist, to identify the file's
+// language, then maps it to a registered grammar:
+//
+//	if g := linguist.Detect(filename, content); g != nil {
+//		result, err := g.Parse(antlr.NewInputStream(string(content)))
+//		...
+//	}
+//
+// When linguist doesn't know the language, the candidate grammars are chosen
+// by file extension, and the content is sniffed by running each grammar's
+// lexer over it.
+//
+// Detect is behind a Go 1.17 build constraint, so that the lexer-only
+// fallbacks, ByExtension and Sniff, still build with the older releases of Go
+// the rest of the repository supports.
+package linguist // import "bramp.net/antlr4/grammars/linguist"
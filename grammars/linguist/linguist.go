@@ -0,0 +1,85 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.17
+// +build go1.17
+
+package linguist
+
+import (
+	"strings"
+
+	"bramp.net/antlr4/grammars"
+	"github.com/go-enry/go-enry/v2"
+)
+
+// Languages maps linguist's language names to grammar names, where the
+// grammar isn't simply named after the lower cased language.
+var Languages = map[string]string{
+	"COBOL":                   "cobol85",
+	"Dart":                    "dart2",
+	"Graph Modeling Language": "gml",
+	"Graphviz (DOT)":          "dot",
+	"Java Properties":         "properties",
+	"JavaScript":              "ecmascript",
+	"M":                       "mumps",
+	"Objective-C":             "objectivec",
+	"Regular Expression":      "regex",
+	"Wavefront Object":        "wavefrontobj",
+	"reStructuredText":        "restructuredtext",
+}
+
+// Grammar returns the registered grammar for the linguist language name,
+// e.g "JavaScript", or nil if there is none.
+func Grammar(language string) *grammars.Grammar {
+	if language == enry.OtherLanguage {
+		return nil
+	}
+	if name, ok := Languages[language]; ok {
+		return grammars.Lookup(name)
+	}
+	return grammars.Lookup(strings.ToLower(language))
+}
+
+// Detect returns the grammar to parse the file with, or nil if none was
+// found. The content may be truncated, but is used to resolve ambiguous
+// extensions, and to sniff when linguist doesn't recognise the language.
+func Detect(filename string, content []byte) *grammars.Grammar {
+	return DetectTier(filename, content, grammars.Untested)
+}
+
+// DetectTier is like Detect, but only returns a grammar of at least the min
+// tier, e.g grammars.Stable.
+func DetectTier(filename string, content []byte, min grammars.Tier) *grammars.Grammar {
+	if g := Grammar(enry.GetLanguage(filename, content)); g != nil && g.Tier >= min {
+		return g
+	}
+
+	var candidates []*grammars.Grammar
+	seen := make(map[*grammars.Grammar]bool)
+	add := func(g *grammars.Grammar) {
+		if g != nil && !seen[g] && g.Tier >= min {
+			seen[g] = true
+			candidates = append(candidates, g)
+		}
+	}
+	for _, language := range enry.GetLanguagesByExtension(filename, content, nil) {
+		add(Grammar(language))
+	}
+	for _, g := range ByExtension(filename) {
+		add(g)
+	}
+
+	return Sniff(content, candidates)
+}
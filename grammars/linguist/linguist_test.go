@@ -0,0 +1,68 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linguist_test
+
+import (
+	"testing"
+
+	"bramp.net/antlr4/grammars"
+	"bramp.net/antlr4/grammars/linguist"
+	_ "bramp.net/antlr4/json"
+)
+
+func TestByExtension(t *testing.T) {
+	tests := []struct {
+		filename string
+		want     string
+	}{
+		{"example.json", "json"},
+		{"EXAMPLE.JSON", "json"},
+		{"example.unknown", ""},
+		{"example", ""},
+	}
+
+	for _, test := range tests {
+		got := ""
+		for _, g := range linguist.ByExtension(test.filename) {
+			got = g.Name
+		}
+		if got != test.want {
+			t.Errorf("ByExtension(%q) = %q, want %q", test.filename, got, test.want)
+		}
+	}
+}
+
+func TestSniff(t *testing.T) {
+	candidates := []*grammars.Grammar{grammars.Lookup("json")}
+
+	tests := []struct {
+		content string
+		want    string
+	}{
+		{`{"a": [1, 2, true]}`, "json"},
+		{`{"a": [1, 2`, "json"}, // Valid tokens, even if it doesn't parse.
+		{`<a href="#">`, ""},
+	}
+
+	for _, test := range tests {
+		got := ""
+		if g := linguist.Sniff([]byte(test.content), candidates); g != nil {
+			got = g.Name
+		}
+		if got != test.want {
+			t.Errorf("Sniff(%q) = %q, want %q", test.content, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package linguist
+
+import (
+	"path/filepath"
+
+	"bramp.net/antlr4/grammars"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// ByExtension returns the registered grammars with an example input sharing
+// the filename's extension.
+func ByExtension(filename string) []*grammars.Grammar {
+	return grammars.ByExtension(filepath.Ext(filename))
+}
+
+// Sniff returns the first candidate whose lexer reads the whole content
+// without a syntax error, or nil if none do.
+func Sniff(content []byte, candidates []*grammars.Grammar) *grammars.Grammar {
+	for _, g := range candidates {
+		if _, errors := g.Tokenize(antlr.NewInputStream(string(content))); len(errors) == 0 {
+			return g
+		}
+	}
+	return nil
+}
@@ -0,0 +1,256 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package literals extracts the string literals and comments from input, for
+// tools such as i18n scanners, secret detectors and documentation
+// extractors.
+//
+// Tokens are classified with the tokenclass package. Strings are unescaped
+// when they use common quoting rules, C-like backslash escapes or doubled
+// quotes, and comments have their markers, such as "//" or "/*", removed.
+//
+// Tokens the grammar skips (with "-> skip") never reach the token stream, so
+// grammars that skip their comments return no comments.
+package literals // import "bramp.net/antlr4/grammars/literals"
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"bramp.net/antlr4/grammars"
+	"bramp.net/antlr4/grammars/tokenclass"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// Kind is the kind of Literal.
+type Kind int
+
+const (
+	String Kind = iota
+	Comment
+)
+
+func (k Kind) String() string {
+	if k == Comment {
+		return "comment"
+	}
+	return "string"
+}
+
+// Literal is a string or comment found in the input.
+type Literal struct {
+	Kind Kind
+	Text string // As it appears in the input
+
+	// Value is the unescaped string, or the comment without its markers. If
+	// the string's quoting wasn't understood, Value is the same as Text, and
+	// Unescaped is false.
+	Value     string
+	Unescaped bool
+
+	Line   int // Line number, starting at 1
+	Column int // Column number, starting at 0
+	Start  int // Index of the first character (in runes)
+	Stop   int // Index of the last character (in runes)
+}
+
+// Extract returns the literals in the parsed result's tokens, on any channel.
+func Extract(result *grammars.Result) []*Literal {
+	result.Tokens.Fill()
+	return FromTokens(result.Grammar, result.Tokens.GetAllTokens())
+}
+
+// ExtractString lexes the input, and returns its literals. Unlike Extract
+// this works with grammars that only define a Lexer.
+func ExtractString(g *grammars.Grammar, input string) []*Literal {
+	tokens, _ := g.Tokenize(antlr.NewInputStream(input))
+	return FromTokens(g, tokens)
+}
+
+// FromTokens returns the literals in the grammar's tokens.
+func FromTokens(g *grammars.Grammar, tokens []antlr.Token) []*Literal {
+	classifier := tokenclass.New(g)
+
+	var literals []*Literal
+	for _, tok := range tokens {
+		l := &Literal{
+			Text:   tok.GetText(),
+			Line:   tok.GetLine(),
+			Column: tok.GetColumn(),
+			Start:  tok.GetStart(),
+			Stop:   tok.GetStop(),
+		}
+
+		switch classifier.Class(tok.GetTokenType()) {
+		case tokenclass.String:
+			l.Kind = String
+			l.Value, l.Unescaped = Unquote(l.Text)
+			if !l.Unescaped {
+				l.Value = l.Text
+			}
+
+		case tokenclass.Comment:
+			l.Kind = Comment
+			l.Value = StripComment(l.Text)
+			l.Unescaped = true
+
+		default:
+			continue
+		}
+		literals = append(literals, l)
+	}
+	return literals
+}
+
+// commentMarkers are the start and end of comments, longest first.
+var commentMarkers = [][2]string{
+	{"<!--", "-->"},
+	{"/**", "*/"},
+	{"/*", "*/"},
+	{"(*", "*)"},
+	{"{-", "-}"},
+	{"///", ""},
+	{"//", ""},
+	{"--", ""},
+	{"#", ""},
+	{";", ""},
+	{"%", ""},
+	{"'", ""},
+}
+
+// StripComment returns the comment without its start and end markers, or
+// surrounding whitespace. Leading "*"s on the lines of a block comment are
+// also removed.
+func StripComment(text string) string {
+	s := strings.TrimSpace(text)
+	for _, m := range commentMarkers {
+		if !strings.HasPrefix(s, m[0]) || !strings.HasSuffix(s[len(m[0]):], m[1]) {
+			continue
+		}
+		s = s[len(m[0]) : len(s)-len(m[1])]
+		if m[1] == "*/" {
+			lines := strings.Split(s, "\n")
+			for i, line := range lines {
+				lines[i] = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "*"))
+			}
+			s = strings.Join(lines, "\n")
+		}
+		return strings.TrimSpace(s)
+	}
+	return s
+}
+
+// Unquote returns the value of a string literal, quoted with ", ' or `.
+// Backslash escapes (as in C, Java or JavaScript) and doubled quotes (as in
+// SQL) are replaced, except within backquotes. It returns false if the
+// quoting isn't understood.
+func Unquote(s string) (string, bool) {
+	if len(s) < 2 {
+		return "", false
+	}
+	q := s[0]
+	if (q != '"' && q != '\'' && q != '`') || s[len(s)-1] != q {
+		return "", false
+	}
+	s = s[1 : len(s)-1]
+	if q == '`' {
+		return s, true
+	}
+
+	var buf bytes.Buffer
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == q:
+			// Only valid if doubled.
+			if i+1 >= len(s) || s[i+1] != q {
+				return "", false
+			}
+			buf.WriteByte(q)
+			i++
+
+		case c == '\\':
+			if i+1 >= len(s) {
+				return "", false
+			}
+			n, ok := unescape(&buf, s[i+1:])
+			if !ok {
+				return "", false
+			}
+			i += n
+
+		default:
+			buf.WriteByte(c)
+		}
+	}
+	return buf.String(), true
+}
+
+var escapes = map[byte]string{
+	'a': "\a", 'b': "\b", 'f': "\f", 'n': "\n", 'r': "\r", 't': "\t", 'v': "\v",
+	'0': "\x00", '\\': "\\", '\'': "'", '"': "\"", '`': "`", '/': "/",
+	'\n': "", // Line continuation
+}
+
+// unescape writes the escape sequence at the start of s (after the
+// backslash), and returns the number of bytes it used.
+func unescape(buf *bytes.Buffer, s string) (int, bool) {
+	if e, ok := escapes[s[0]]; ok {
+		buf.WriteString(e)
+		return 1, true
+	}
+
+	digits := 0
+	switch s[0] {
+	case 'x':
+		digits = 2
+	case 'u':
+		digits = 4
+	case 'U':
+		digits = 8
+	default:
+		return 0, false
+	}
+	if len(s) < 1+digits {
+		return 0, false
+	}
+	v, err := strconv.ParseUint(s[1:1+digits], 16, 32)
+	if err != nil {
+		return 0, false
+	}
+	n := 1 + digits
+	r := rune(v)
+
+	// JSON and JavaScript encode astral characters as a UTF-16 surrogate
+	// pair, e.g \uD83D\uDE00.
+	if s[0] == 'u' && utf16.IsSurrogate(r) && len(s) >= 2*n+1 && s[n:n+2] == "\\u" {
+		if low, err := strconv.ParseUint(s[n+2:2*n+1], 16, 32); err == nil {
+			r = utf16.DecodeRune(r, rune(low))
+			n = 2*n + 1
+		}
+	}
+
+	if s[0] == 'x' {
+		buf.WriteByte(byte(v))
+		return n, true
+	}
+	if !utf8.ValidRune(r) {
+		return 0, false
+	}
+	buf.WriteRune(r)
+	return n, true
+}
@@ -0,0 +1,114 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package literals_test
+
+import (
+	"testing"
+
+	"bramp.net/antlr4/grammars"
+	"bramp.net/antlr4/grammars/literals"
+	_ "bramp.net/antlr4/json"
+	_ "bramp.net/antlr4/properties"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/kylelemons/godebug/pretty"
+)
+
+func TestUnquote(t *testing.T) {
+	tests := []struct {
+		s    string
+		want string
+		ok   bool
+	}{
+		{`"hello"`, "hello", true},
+		{`'hello'`, "hello", true},
+		{"`a\\nb`", `a\nb`, true},
+		{`"a\nb\t\"c\""`, "a\nb\t\"c\"", true},
+		{`'it''s'`, "it's", true},
+		{`"\x41é\U0001F600"`, "Aé😀", true},
+		{`"😀"`, "😀", true},
+		{`""`, "", true},
+
+		{`"`, "", false},
+		{`hello`, "", false},
+		{`"hello'`, "", false},
+		{`"a"b"`, "", false},
+		{`"\q"`, "", false},
+		{`"\u12"`, "", false},
+		{`"abc\"`, "", false},
+	}
+
+	for _, test := range tests {
+		got, ok := literals.Unquote(test.s)
+		if got != test.want || ok != test.ok {
+			t.Errorf("Unquote(%q) = (%q, %t), want (%q, %t)", test.s, got, ok, test.want, test.ok)
+		}
+	}
+}
+
+func TestStripComment(t *testing.T) {
+	tests := []struct {
+		text string
+		want string
+	}{
+		{"// hello", "hello"},
+		{"# hello\n", "hello"},
+		{"-- hello", "hello"},
+		{"/* hello */", "hello"},
+		{"/**\n * Hello\n * world\n */", "Hello\nworld"},
+		{"(* hello *)", "hello"},
+		{"<!-- hello -->", "hello"},
+		{"hello", "hello"},
+	}
+
+	for _, test := range tests {
+		if got := literals.StripComment(test.text); got != test.want {
+			t.Errorf("StripComment(%q) = %q, want %q", test.text, got, test.want)
+		}
+	}
+}
+
+func TestExtract(t *testing.T) {
+	const input = `{"a\n": "\u00e9", "b": 1}`
+
+	result, err := grammars.Lookup("json").Parse(antlr.NewInputStream(input))
+	if err != nil {
+		t.Fatalf("Parse(%q) err = %s, want nil", input, err)
+	}
+
+	want := []*literals.Literal{
+		{Kind: literals.String, Text: `"a\n"`, Value: "a\n", Unescaped: true, Line: 1, Column: 1, Start: 1, Stop: 5},
+		{Kind: literals.String, Text: `"\u00e9"`, Value: "é", Unescaped: true, Line: 1, Column: 8, Start: 8, Stop: 15},
+		{Kind: literals.String, Text: `"b"`, Value: "b", Unescaped: true, Line: 1, Column: 18, Start: 18, Stop: 20},
+	}
+	if diff := pretty.Compare(literals.Extract(result), want); diff != "" {
+		t.Errorf("Extract(%q) diff: (-got +want)\n%s", input, diff)
+	}
+}
+
+func TestExtractComments(t *testing.T) {
+	const input = "# a comment\nkey=value\n"
+
+	var got []string
+	for _, l := range literals.ExtractString(grammars.Lookup("properties"), input) {
+		if l.Kind == literals.Comment {
+			got = append(got, l.Value)
+		}
+	}
+
+	want := []string{"a comment"}
+	if diff := pretty.Compare(got, want); diff != "" {
+		t.Errorf("ExtractString(%q) diff: (-got +want)\n%s", input, diff)
+	}
+}
@@ -0,0 +1,305 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package lsp is a minimal Language Server, that reports the syntax errors
+// found by any of the grammars as diagnostics. The grammar is chosen by the
+// document's language identifier, or failing that the grammar with examples
+// of the same file extension, so an editor can be configured to use it for
+// any language.
+//
+// Only full document synchronization is supported. Columns are converted from
+// ANTLR's Unicode code points to the UTF-16 code units the protocol
+// specifies, so are correct on lines containing astral plane characters.
+package lsp // import "bramp.net/antlr4/grammars/lsp"
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"path"
+	"strings"
+	"unicode/utf16"
+
+	"bramp.net/antlr4/grammars"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// Server is a Language Server communicating over a single connection, such as
+// stdin and stdout.
+type Server struct {
+	in  *bufio.Reader
+	out io.Writer
+
+	// Logger is used to log problems that can't be reported to the client.
+	// If nil, nothing is logged.
+	Logger *log.Logger
+
+	docs     map[string]*grammars.Grammar // Grammar of each open document, keyed by URI
+	shutdown bool
+}
+
+// NewServer returns a Server reading requests from in, and writing responses
+// to out.
+func NewServer(in io.Reader, out io.Writer) *Server {
+	return &Server{
+		in:   bufio.NewReader(in),
+		out:  out,
+		docs: make(map[string]*grammars.Grammar),
+	}
+}
+
+// Serve handles requests until the client sends the exit notification, or
+// the connection is closed.
+func (s *Server) Serve() error {
+	for {
+		m, err := readMessage(s.in)
+		if err == io.EOF {
+			return nil
+		}
+		if rerr, ok := err.(*responseError); ok {
+			s.reply(nil, nil, rerr)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if m.Method == "exit" {
+			if !s.shutdown {
+				return fmt.Errorf("lsp: exit without shutdown")
+			}
+			return nil
+		}
+
+		result, rerr := s.handle(m)
+		if m.ID != nil {
+			s.reply(m.ID, result, rerr)
+		} else if rerr != nil {
+			s.logf("lsp: %s: %s", m.Method, rerr)
+		}
+	}
+}
+
+// handle handles a single request or notification.
+func (s *Server) handle(m *message) (interface{}, *responseError) {
+	switch m.Method {
+	case "initialize":
+		return &initializeResult{
+			Capabilities: serverCapabilities{TextDocumentSync: syncFull},
+			ServerInfo:   &serverInfo{Name: "bramp.net/antlr4"},
+		}, nil
+
+	case "shutdown":
+		s.shutdown = true
+		return nil, nil
+
+	case "textDocument/didOpen":
+		var params didOpenParams
+		if err := unmarshal(m.Params, &params); err != nil {
+			return nil, err
+		}
+		doc := params.TextDocument
+		g := Detect(doc.URI, doc.LanguageID, doc.Text)
+		if g == nil {
+			s.logf("lsp: no grammar for %s (language %q)", doc.URI, doc.LanguageID)
+			return nil, nil
+		}
+		s.docs[doc.URI] = g
+		s.diagnose(g, doc.URI, doc.Version, doc.Text)
+
+	case "textDocument/didChange":
+		var params didChangeParams
+		if err := unmarshal(m.Params, &params); err != nil {
+			return nil, err
+		}
+		doc := params.TextDocument
+		g := s.docs[doc.URI]
+		if g == nil || len(params.ContentChanges) == 0 {
+			return nil, nil
+		}
+		// With full synchronization, the last change is the whole document.
+		s.diagnose(g, doc.URI, doc.Version, params.ContentChanges[len(params.ContentChanges)-1].Text)
+
+	case "textDocument/didClose":
+		var params didCloseParams
+		if err := unmarshal(m.Params, &params); err != nil {
+			return nil, err
+		}
+		if _, found := s.docs[params.TextDocument.URI]; found {
+			delete(s.docs, params.TextDocument.URI)
+			s.publish(params.TextDocument.URI, 0, nil)
+		}
+
+	default:
+		if m.ID != nil {
+			return nil, &responseError{Code: codeMethodNotFound, Message: fmt.Sprintf("method %q not supported", m.Method)}
+		}
+		// Other notifications, such as "initialized", are ignored.
+	}
+	return nil, nil
+}
+
+// Detect returns the grammar for the document, from its language identifier
+// (e.g "json"), or failing that its file extension, matched against the
+// extensions of each grammar's examples. If several grammars share the
+// extension, the first whose lexer reads the text without errors is chosen.
+// It returns nil if no grammar was found.
+func Detect(uri, languageID, text string) *grammars.Grammar {
+	if g := grammars.Lookup(strings.ToLower(languageID)); g != nil {
+		return g
+	}
+
+	p := uri
+	if u, err := url.Parse(uri); err == nil {
+		p = u.Path
+	}
+	candidates := grammars.ByExtension(path.Ext(p))
+	if len(candidates) > 1 {
+		for _, g := range candidates {
+			if _, errors := g.Tokenize(antlr.NewInputStream(text)); len(errors) == 0 {
+				return g
+			}
+		}
+	}
+	if len(candidates) > 0 {
+		return candidates[0]
+	}
+	return nil
+}
+
+// diagnose parses the text, and publishes any syntax errors.
+func (s *Server) diagnose(g *grammars.Grammar, uri string, version int, text string) {
+	var errors []*grammars.SyntaxError
+	if g.HasParser() {
+		result, err := g.Parse(antlr.NewInputStream(text))
+		if err != nil {
+			s.logf("lsp: %s: %s", uri, err)
+			return
+		}
+		errors = result.Errors
+	} else {
+		_, errors = g.Tokenize(antlr.NewInputStream(text))
+	}
+
+	lines := strings.Split(text, "\n")
+	diagnostics := []diagnostic{}
+	for _, e := range errors {
+		start, end := errorRange(lines, e.Line, e.Column)
+		diagnostics = append(diagnostics, diagnostic{
+			Range:    textRange{Start: start, End: end},
+			Severity: severityError,
+			Source:   g.Name,
+			Message:  e.Msg,
+		})
+	}
+	s.publish(uri, version, diagnostics)
+}
+
+// errorRange returns the range of the character at the ANTLR line (starting at
+// 1) and column (in code points, starting at 0). LSP counts characters in
+// UTF-16 code units, where characters outside the Basic Multilingual Plane
+// take two.
+func errorRange(lines []string, line, column int) (start, end position) {
+	start = position{Line: line - 1, Character: column}
+	end = position{Line: line - 1, Character: column + 1}
+	if line < 1 || line > len(lines) {
+		return start, end
+	}
+
+	units, i, size := 0, 0, 1
+	for _, r := range lines[line-1] {
+		if i == column {
+			size = len(utf16.Encode([]rune{r}))
+			break
+		}
+		units += len(utf16.Encode([]rune{r}))
+		i++
+	}
+	// Past the end of the line, such as an error at EOF.
+	units += column - i
+
+	start.Character = units
+	end.Character = units + size
+	return start, end
+}
+
+func (s *Server) publish(uri string, version int, diagnostics []diagnostic) {
+	if diagnostics == nil {
+		diagnostics = []diagnostic{}
+	}
+	s.notify("textDocument/publishDiagnostics", &publishDiagnosticsParams{
+		URI:         uri,
+		Version:     version,
+		Diagnostics: diagnostics,
+	})
+}
+
+func (s *Server) notify(method string, params interface{}) {
+	raw, err := marshal(params)
+	if err != nil {
+		s.logf("lsp: %s: %s", method, err)
+		return
+	}
+	if err := writeMessage(s.out, &message{Method: method, Params: raw}); err != nil {
+		s.logf("lsp: %s: %s", method, err)
+	}
+}
+
+func (s *Server) reply(id *json.RawMessage, result interface{}, rerr *responseError) {
+	m := &message{ID: id, Error: rerr}
+	if id == nil {
+		// Responses to unparsable requests must have a null id.
+		null := json.RawMessage("null")
+		m.ID = &null
+	}
+	if rerr == nil {
+		raw, err := marshal(result)
+		if err != nil {
+			s.logf("lsp: %s", err)
+			return
+		}
+		m.Result = raw
+	}
+	if err := writeMessage(s.out, m); err != nil {
+		s.logf("lsp: %s", err)
+	}
+}
+
+func (s *Server) logf(format string, args ...interface{}) {
+	if s.Logger != nil {
+		s.Logger.Printf(format, args...)
+	}
+}
+
+func marshal(v interface{}) (*json.RawMessage, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	raw := json.RawMessage(b)
+	return &raw, nil
+}
+
+func unmarshal(params *json.RawMessage, v interface{}) *responseError {
+	if params == nil {
+		return &responseError{Code: codeInvalidParams, Message: "missing params"}
+	}
+	if err := json.Unmarshal(*params, v); err != nil {
+		return &responseError{Code: codeInvalidParams, Message: err.Error()}
+	}
+	return nil
+}
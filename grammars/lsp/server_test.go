@@ -0,0 +1,176 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lsp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	_ "bramp.net/antlr4/json"
+)
+
+func TestServer(t *testing.T) {
+	var in bytes.Buffer
+	for _, m := range []string{
+		`{"jsonrpc": "2.0", "id": 1, "method": "initialize", "params": {}}`,
+		`{"jsonrpc": "2.0", "method": "initialized", "params": {}}`,
+		`{"jsonrpc": "2.0", "method": "textDocument/didOpen", "params": {"textDocument": {"uri": "file:///a.json", "languageId": "json", "version": 1, "text": "{\"a\": 1}"}}}`,
+		`{"jsonrpc": "2.0", "method": "textDocument/didChange", "params": {"textDocument": {"uri": "file:///a.json", "version": 2}, "contentChanges": [{"text": "{\"a\": }"}]}}`,
+		`{"jsonrpc": "2.0", "id": 2, "method": "textDocument/hover", "params": {}}`,
+		`{"jsonrpc": "2.0", "method": "textDocument/didClose", "params": {"textDocument": {"uri": "file:///a.json"}}}`,
+		`{"jsonrpc": "2.0", "id": 3, "method": "shutdown"}`,
+		`{"jsonrpc": "2.0", "method": "exit"}`,
+	} {
+		fmt.Fprintf(&in, "Content-Length: %d\r\n\r\n%s", len(m), m)
+	}
+
+	var out bytes.Buffer
+	if err := NewServer(&in, &out).Serve(); err != nil {
+		t.Fatalf("Serve() err = %s, want nil", err)
+	}
+
+	raw := out.String()
+
+	var got []*message
+	r := bufio.NewReader(&out)
+	for {
+		m, err := readMessage(r)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("readMessage() err = %s, want nil", err)
+		}
+		got = append(got, m)
+	}
+
+	// initialize, 2 x publishDiagnostics, hover, publishDiagnostics, shutdown
+	if len(got) != 6 {
+		t.Fatalf("Serve() sent %d messages, want 6", len(got))
+	}
+
+	if got[0].Result == nil || got[0].Error != nil {
+		t.Errorf("initialize response = %+v, want a result", got[0])
+	}
+
+	wantDiagnostics := []struct {
+		message     int
+		diagnostics int
+	}{
+		{1, 0}, // didOpen (valid)
+		{2, 1}, // didChange (invalid)
+		{4, 0}, // didClose
+	}
+	for _, want := range wantDiagnostics {
+		m := got[want.message]
+		if m.Method != "textDocument/publishDiagnostics" {
+			t.Errorf("message %d method = %q, want publishDiagnostics", want.message, m.Method)
+			continue
+		}
+
+		var params publishDiagnosticsParams
+		if err := json.Unmarshal(*m.Params, &params); err != nil {
+			t.Errorf("message %d params err = %s", want.message, err)
+			continue
+		}
+		if params.URI != "file:///a.json" || len(params.Diagnostics) != want.diagnostics {
+			t.Errorf("message %d = %+v, want %d diagnostics for file:///a.json", want.message, params, want.diagnostics)
+		}
+	}
+
+	if got[3].Error == nil || got[3].Error.Code != codeMethodNotFound {
+		t.Errorf("hover response = %+v, want method not found error", got[3])
+	}
+
+	// A successful response must have a result, even if it's null, which
+	// unmarshals to a nil Result, so check what was sent.
+	if want := `{"jsonrpc":"2.0","id":3,"result":null}`; got[5].Error != nil || !strings.Contains(raw, want) {
+		t.Errorf("shutdown response = %+v, want %s", got[5], want)
+	}
+}
+
+func TestReadMessageContentLength(t *testing.T) {
+	const valid = `{"jsonrpc": "2.0", "method": "initialized"}`
+	tests := []string{
+		"Content-Length: -1\r\n\r\n",
+		fmt.Sprintf("Content-Length: %d\r\n\r\n%s", maxContentLength+1, bytes.Repeat([]byte(" "), maxContentLength+1)),
+	}
+
+	for _, input := range tests {
+		r := bufio.NewReader(bytes.NewBufferString(input + fmt.Sprintf("Content-Length: %d\r\n\r\n%s", len(valid), valid)))
+		if _, err := readMessage(r); err == nil {
+			t.Errorf("readMessage(%.20q...) err = nil, want an error", input)
+			continue
+		} else if rerr, ok := err.(*responseError); !ok || rerr.Code != codeInvalidRequest {
+			t.Errorf("readMessage(%.20q...) err = %#v, want invalid request", input, err)
+		}
+
+		// The next message is still read.
+		if m, err := readMessage(r); err != nil || m.Method != "initialized" {
+			t.Errorf("readMessage(%.20q...) next message = %+v, %v, want initialized", input, m, err)
+		}
+	}
+}
+
+func TestDetect(t *testing.T) {
+	tests := []struct {
+		uri        string
+		languageID string
+		want       string
+	}{
+		{"file:///a.txt", "json", "json"},
+		{"file:///a.json", "", "json"},
+		{"file:///dir/a.JSON", "plaintext", "json"},
+		{"file:///a.txt", "plaintext", ""},
+		{"file:///json", "plaintext", ""}, // A name, not an extension
+	}
+
+	for _, test := range tests {
+		got := ""
+		if g := Detect(test.uri, test.languageID, `{"a": 1}`); g != nil {
+			got = g.Name
+		}
+		if got != test.want {
+			t.Errorf("Detect(%q, %q) = %q, want %q", test.uri, test.languageID, got, test.want)
+		}
+	}
+}
+
+func TestErrorRange(t *testing.T) {
+	lines := []string{"a\U0001F600b", "\U0002000B\U0002000Bc"}
+	tests := []struct {
+		line, column int
+		start, end   int
+	}{
+		{1, 0, 0, 1},
+		{1, 1, 1, 3}, // The emoji is two UTF-16 code units
+		{1, 2, 3, 4},
+		{1, 3, 4, 5}, // End of the line
+		{2, 2, 4, 5},
+		{3, 0, 0, 1}, // Missing line
+	}
+
+	for _, test := range tests {
+		start, end := errorRange(lines, test.line, test.column)
+		if start.Character != test.start || end.Character != test.end || start.Line != test.line-1 {
+			t.Errorf("errorRange(%d, %d) = %+v, %+v, want characters %d to %d", test.line, test.column, start, end, test.start, test.end)
+		}
+	}
+}
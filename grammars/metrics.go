@@ -0,0 +1,104 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grammars
+
+import (
+	"reflect"
+	"sync"
+	"time"
+)
+
+// cacheMu guards the DFA caches of every grammar while they are counted.
+// Lexing and parsing, which add to the caches, hold the read lock, and
+// counting holds the write lock, so the caches never change while counted.
+var cacheMu sync.RWMutex
+
+// Metrics counts parses, failures, durations and cache sizes per grammar.
+// The expvarmetrics and prommetrics packages contain ready-made
+// implementations.
+type Metrics interface {
+	// ObserveParse is called once parsing has finished, with how long it
+	// took, and the number of syntax errors found. A parse with errors
+	// should be counted as a failure.
+	ObserveParse(g *Grammar, duration time.Duration, errors int)
+
+	// ObserveCacheSize is called after each parse with the number of DFA
+	// states cached by the grammar's lexer and parser. The cache is shared
+	// by every parse with the same grammar, and grows with the variety of
+	// the input, never shrinking. Counting the states waits for other
+	// parses to finish using the cache, so briefly serializes parsing.
+	ObserveCacheSize(g *Grammar, states int)
+}
+
+// WithMetrics sets the Metrics used to count parsing.
+func WithMetrics(m Metrics) Option {
+	return func(o *options) {
+		o.metrics = m
+	}
+}
+
+// cacheSize returns the number of DFA states cached by the recognizers,
+// waiting for any lexing or parsing to finish first.
+func cacheSize(recognizers ...interface{}) int {
+	cacheMu.Lock()
+	defer cacheMu.Unlock()
+
+	n := 0
+	for _, r := range recognizers {
+		n += dfaStates(r)
+	}
+	return n
+}
+
+// dfaStates returns the number of DFA states cached by the recognizer's
+// ATN simulator. The runtime doesn't export the cache, so this peeks at the
+// unexported fields.
+func dfaStates(recognizer interface{}) int {
+	v := indirect(reflect.ValueOf(recognizer))
+	if v.Kind() != reflect.Struct {
+		return 0
+	}
+	v = indirect(v.FieldByName("Interpreter"))
+	if v.Kind() != reflect.Struct {
+		return 0
+	}
+	dfas := v.FieldByName("decisionToDFA")
+	if dfas.Kind() != reflect.Slice {
+		return 0
+	}
+
+	n := 0
+	for i := 0; i < dfas.Len(); i++ {
+		dfa := indirect(dfas.Index(i))
+		if dfa.Kind() != reflect.Struct {
+			continue
+		}
+		if states := dfa.FieldByName("states"); states.Kind() == reflect.Map || states.Kind() == reflect.Slice {
+			n += states.Len()
+		}
+	}
+	return n
+}
+
+// indirect follows pointers and interfaces until it finds a concrete value.
+func indirect(v reflect.Value) reflect.Value {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return reflect.Value{}
+		}
+		v = v.Elem()
+	}
+	return v
+}
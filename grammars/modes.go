@@ -0,0 +1,135 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grammars
+
+import (
+	"reflect"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// ModeChange is how a token changed the lexer's mode, for example with the
+// pushMode or popMode lexer commands.
+type ModeChange int
+
+const (
+	ModeUnchanged ModeChange = iota
+	ModePush
+	ModePop
+	ModeSet
+)
+
+func (c ModeChange) String() string {
+	switch c {
+	case ModeUnchanged:
+		return "unchanged"
+	case ModePush:
+		return "push"
+	case ModePop:
+		return "pop"
+	case ModeSet:
+		return "set"
+	}
+	return "unknown"
+}
+
+// ModeToken is a token, with the lexer mode it was read in.
+type ModeToken struct {
+	antlr.Token
+
+	Mode   int        // Mode the token was read in, see Grammar.ModeNames
+	Change ModeChange // How the token changed the mode
+	Next   int        // Mode after the token was read
+	Depth  int        // Depth of the mode stack after the token was read
+}
+
+// TokenizeModes is the same as Tokenize, but also records the lexer's mode
+// for each token, and how the mode stack was pushed or popped. This is
+// useful to highlight grammars with many modes, such as xml, where the same
+// text is a different token depending on the mode.
+func (g *Grammar) TokenizeModes(input antlr.CharStream) ([]ModeToken, []*SyntaxError) {
+	errors := newErrorCollector(input)
+
+	unlock := g.readFlags()
+	defer unlock()
+	cacheMu.RLock()
+	defer cacheMu.RUnlock()
+
+	lexer := g.NewLexer(g.NewCharStream(input))
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(errors)
+
+	var tokens []ModeToken
+	mode, stack := lexerMode(lexer)
+	for tok := lexer.NextToken(); tok.GetTokenType() != antlr.TokenEOF; tok = lexer.NextToken() {
+		next, nextStack := lexerMode(lexer)
+
+		change := ModeUnchanged
+		switch {
+		case nextStack > stack:
+			change = ModePush
+		case nextStack < stack:
+			change = ModePop
+		case next != mode:
+			change = ModeSet
+		}
+
+		tokens = append(tokens, ModeToken{
+			Token:  tok,
+			Mode:   mode,
+			Change: change,
+			Next:   next,
+			Depth:  nextStack,
+		})
+		mode, stack = next, nextStack
+	}
+	return tokens, errors.errors
+}
+
+// ModeNames returns the names of the lexer's modes, indexed by mode, e.g
+// "DEFAULT_MODE".
+func (g *Grammar) ModeNames() []string {
+	v := indirect(reflect.ValueOf(g.NewLexer(antlr.NewInputStream(""))))
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+	names := v.FieldByName("modeNames")
+	if names.Kind() != reflect.Slice {
+		return nil
+	}
+
+	var modes []string
+	for i := 0; i < names.Len(); i++ {
+		modes = append(modes, names.Index(i).String())
+	}
+	return modes
+}
+
+// lexerMode returns the lexer's current mode, and the depth of its mode
+// stack. The runtime doesn't export either, so this peeks at the unexported
+// fields.
+func lexerMode(lexer antlr.Lexer) (mode, depth int) {
+	v := indirect(reflect.ValueOf(lexer))
+	if v.Kind() != reflect.Struct {
+		return antlr.LexerDefaultMode, 0
+	}
+	if m := v.FieldByName("mode"); m.Kind() == reflect.Int {
+		mode = int(m.Int())
+	}
+	if s := v.FieldByName("modeStack"); s.Kind() == reflect.Slice {
+		depth = s.Len()
+	}
+	return mode, depth
+}
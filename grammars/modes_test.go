@@ -0,0 +1,63 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grammars_test
+
+import (
+	"testing"
+
+	"bramp.net/antlr4/grammars"
+	_ "bramp.net/antlr4/xml"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/kylelemons/godebug/pretty"
+)
+
+func TestTokenizeModes(t *testing.T) {
+	g := grammars.Lookup("xml")
+
+	type modeToken struct {
+		Text   string
+		Mode   int
+		Change grammars.ModeChange
+		Depth  int
+	}
+
+	tokens, errors := g.TokenizeModes(antlr.NewInputStream("<a>t</a>"))
+	if len(errors) > 0 {
+		t.Fatalf("TokenizeModes(...) errors = %v, want none", errors)
+	}
+
+	var got []modeToken
+	for _, tok := range tokens {
+		got = append(got, modeToken{tok.GetText(), tok.Mode, tok.Change, tok.Depth})
+	}
+
+	want := []modeToken{
+		{"<", 0, grammars.ModePush, 1},
+		{"a", 1, grammars.ModeUnchanged, 1},
+		{">", 1, grammars.ModePop, 0},
+		{"t", 0, grammars.ModeUnchanged, 0},
+		{"<", 0, grammars.ModePush, 1},
+		{"/", 1, grammars.ModeUnchanged, 1},
+		{"a", 1, grammars.ModeUnchanged, 1},
+		{">", 1, grammars.ModePop, 0},
+	}
+	if diff := pretty.Compare(got, want); diff != "" {
+		t.Errorf("TokenizeModes(...) diff: (-got +want)\n%s", diff)
+	}
+
+	if got, want := g.ModeNames(), []string{"DEFAULT_MODE", "INSIDE", "PROC_INSTR"}; pretty.Compare(got, want) != "" {
+		t.Errorf("ModeNames() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,69 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grammars
+
+import (
+	"context"
+	"io"
+)
+
+// Option configures how Parse lexes and parses the input.
+type Option func(*options)
+
+type options struct {
+	ctx          context.Context
+	tracer       Tracer
+	metrics      Metrics
+	preprocessor Preprocessor
+	flags        map[string]bool
+	trace        io.Writer
+	rule         string
+	ruleSet      bool // WithStartRule was given, even with an empty rule
+	span         *Span
+}
+
+func newOptions(opts []Option) *options {
+	o := &options{
+		ctx: context.Background(),
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// WithContext sets the context passed to the Tracer, for example so spans
+// are recorded as children of the caller's span.
+func WithContext(ctx context.Context) Option {
+	return func(o *options) {
+		o.ctx = ctx
+	}
+}
+
+// Tracer observes each call to Parse, for example to record how long
+// parsing takes in production.
+type Tracer interface {
+	// StartParse is called before the input is lexed and parsed. It returns
+	// a function that is called once parsing has finished, with the number
+	// of tokens read, and number of syntax errors found.
+	StartParse(ctx context.Context, g *Grammar, inputSize int) (end func(tokens, errors int))
+}
+
+// WithTracer sets the Tracer used to observe parsing.
+func WithTracer(t Tracer) Option {
+	return func(o *options) {
+		o.tracer = t
+	}
+}
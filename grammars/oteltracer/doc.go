@@ -0,0 +1,26 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package oteltracer records each parse as a OpenTelemetry span, with the
+// grammar's name, input size, token count and error count as attributes.
+//
+//	result, err := g.Parse(input,
+//		grammars.WithContext(ctx),
+//		grammars.WithTracer(oteltracer.New(nil)))
+//
+// The spans are created with whichever TracerProvider supplied the tracer, so
+// sampling and export are configured by the application as usual. The Tracer
+// is in a file constrained to Go 1.17 and later, the oldest release the
+// OpenTelemetry module supports; older toolchains see an empty package.
+package oteltracer // import "bramp.net/antlr4/grammars/oteltracer"
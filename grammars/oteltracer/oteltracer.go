@@ -0,0 +1,71 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.17
+// +build go1.17
+
+package oteltracer
+
+import (
+	"context"
+	"fmt"
+
+	"bramp.net/antlr4/grammars"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Attribute keys set on each span.
+const (
+	GrammarKey   = "antlr4.grammar"
+	InputSizeKey = "antlr4.input.size" // In code points
+	TokensKey    = "antlr4.tokens"
+	ErrorsKey    = "antlr4.errors"
+)
+
+// Tracer is a grammars.Tracer that records OpenTelemetry spans.
+type Tracer struct {
+	tracer trace.Tracer
+}
+
+// New returns a Tracer using the OpenTelemetry tracer, or if nil, a tracer
+// named "bramp.net/antlr4" from the global TracerProvider.
+func New(tracer trace.Tracer) *Tracer {
+	if tracer == nil {
+		tracer = otel.Tracer("bramp.net/antlr4")
+	}
+	return &Tracer{tracer: tracer}
+}
+
+// StartParse starts a span named "antlr4.Parse", ended once parsing finishes.
+// Spans are marked as errors if there were syntax errors.
+func (t *Tracer) StartParse(ctx context.Context, g *grammars.Grammar, inputSize int) func(tokens, errors int) {
+	_, span := t.tracer.Start(ctx, "antlr4.Parse", trace.WithAttributes(
+		attribute.String(GrammarKey, g.Name),
+		attribute.Int(InputSizeKey, inputSize),
+	))
+
+	return func(tokens, errors int) {
+		span.SetAttributes(
+			attribute.Int(TokensKey, tokens),
+			attribute.Int(ErrorsKey, errors),
+		)
+		if errors > 0 {
+			span.SetStatus(codes.Error, fmt.Sprintf("%d syntax errors", errors))
+		}
+		span.End()
+	}
+}
@@ -0,0 +1,85 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.17
+// +build go1.17
+
+package oteltracer_test
+
+import (
+	"testing"
+
+	"bramp.net/antlr4/grammars"
+	"bramp.net/antlr4/grammars/oteltracer"
+	_ "bramp.net/antlr4/json"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestTracer(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	tracer := oteltracer.New(provider.Tracer("test"))
+
+	tests := []struct {
+		input  string
+		errors int64
+		code   codes.Code
+	}{
+		{`{"a": [1, 2]}`, 0, codes.Unset},
+		{`{"a": [1, 2}`, 1, codes.Error},
+	}
+
+	g := grammars.Lookup("json")
+	for _, test := range tests {
+		if _, err := g.Parse(antlr.NewInputStream(test.input), grammars.WithTracer(tracer)); err != nil {
+			t.Fatalf("Parse(%q) err = %s, want nil", test.input, err)
+		}
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != len(tests) {
+		t.Fatalf("Parse(...) recorded %d spans, want %d", len(spans), len(tests))
+	}
+
+	for i, test := range tests {
+		span := spans[i]
+		if got, want := span.Name(), "antlr4.Parse"; got != want {
+			t.Errorf("Parse(%q) span name = %q, want %q", test.input, got, want)
+		}
+
+		attrs := make(map[attribute.Key]attribute.Value)
+		for _, kv := range span.Attributes() {
+			attrs[kv.Key] = kv.Value
+		}
+		if got, want := attrs[oteltracer.GrammarKey].AsString(), "json"; got != want {
+			t.Errorf("Parse(%q) span %s = %q, want %q", test.input, oteltracer.GrammarKey, got, want)
+		}
+		if got, want := attrs[oteltracer.InputSizeKey].AsInt64(), int64(len(test.input)); got != want {
+			t.Errorf("Parse(%q) span %s = %d, want %d", test.input, oteltracer.InputSizeKey, got, want)
+		}
+		if got := attrs[oteltracer.TokensKey].AsInt64(); got <= 0 {
+			t.Errorf("Parse(%q) span %s = %d, want > 0", test.input, oteltracer.TokensKey, got)
+		}
+		if got := attrs[oteltracer.ErrorsKey].AsInt64(); got != test.errors {
+			t.Errorf("Parse(%q) span %s = %d, want %d", test.input, oteltracer.ErrorsKey, got, test.errors)
+		}
+		if got := span.Status().Code; got != test.code {
+			t.Errorf("Parse(%q) span status = %s, want %s", test.input, got, test.code)
+		}
+	}
+}
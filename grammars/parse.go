@@ -0,0 +1,213 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grammars
+
+import (
+	"fmt"
+	"time"
+
+	"bramp.net/antlr4/internal"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// SyntaxError is a error reported by the Lexer or Parser.
+type SyntaxError struct {
+	Filename string // Filename of the NamedStream being parsed, if any
+	Line     int    // Line number, starting at 1
+	Column   int    // Column number, starting at 0
+	Msg      string
+}
+
+func (e *SyntaxError) Error() string {
+	if e.Filename != "" {
+		return fmt.Sprintf("%s:%d:%d: %s", e.Filename, e.Line, e.Column, e.Msg)
+	}
+	return fmt.Sprintf("%d:%d: %s", e.Line, e.Column, e.Msg)
+}
+
+// errorCollector is a antlr.ErrorListener which records every SyntaxError.
+// The other Report errors are ignored.
+type errorCollector struct {
+	*antlr.DefaultErrorListener
+
+	filename string
+	errors   []*SyntaxError
+}
+
+// newErrorCollector returns a errorCollector for errors found in the input.
+func newErrorCollector(input antlr.CharStream) *errorCollector {
+	c := &errorCollector{}
+	if s := namedStream(input); s != nil {
+		c.filename = s.Filename
+	}
+	return c
+}
+
+func (c *errorCollector) SyntaxError(recognizer antlr.Recognizer, offendingSymbol interface{}, line, column int, msg string, e antlr.RecognitionException) {
+	c.errors = append(c.errors, &SyntaxError{
+		Filename: c.filename,
+		Line:     line,
+		Column:   column,
+		Msg:      msg,
+	})
+}
+
+// Result is the output of parsing some input.
+type Result struct {
+	Grammar *Grammar
+
+	Tokens *antlr.CommonTokenStream
+	Parser antlr.Parser
+	Tree   antlr.ParserRuleContext
+
+	// Errors contains all the syntax errors found by the Lexer and Parser.
+	// The Tree is still returned when there are errors, but may be incomplete.
+	Errors []*SyntaxError
+
+	// SourceMap maps the tokens' offsets back to the input, if it was
+	// changed by a Preprocessor or only a Span was parsed, otherwise it is
+	// nil.
+	SourceMap *SourceMap
+}
+
+// NewCharStream wraps the input so that it is upper or lower cased if the
+// grammar expects it.
+func (g *Grammar) NewCharStream(input antlr.CharStream) antlr.CharStream {
+	switch g.CaseInsensitiveType {
+	case "UPPER":
+		return internal.NewCaseChangingStream(input, true)
+	case "lower":
+		return internal.NewCaseChangingStream(input, false)
+	}
+	return input
+}
+
+// Parse lexes and parses the input starting at the grammar's EntryPoint, or
+// the rule given by WithStartRule.
+func (g *Grammar) Parse(input antlr.CharStream, opts ...Option) (*Result, error) {
+	if !g.HasParser() {
+		return nil, fmt.Errorf("%s: grammar does not define a parser", g.Name)
+	}
+
+	o := newOptions(opts)
+	restore, err := g.setFlags(o.flags)
+	if err != nil {
+		return nil, err
+	}
+	defer restore()
+
+	startRule := g.Start
+	if o.ruleSet {
+		if startRule, err = g.startRule(o.rule); err != nil {
+			return nil, err
+		}
+	}
+
+	preprocessor := o.preprocessor
+	if o.span != nil {
+		if err := checkSpan(*o.span, input.Size()); err != nil {
+			return nil, fmt.Errorf("%s: %s", g.Name, err)
+		}
+		preprocessor = spanPreprocessor(*o.span, preprocessor)
+	}
+
+	var end func(tokens, errors int)
+	if o.tracer != nil {
+		end = o.tracer.StartParse(o.ctx, g, input.Size())
+	}
+	start := time.Now()
+
+	var pre *preprocessed
+	if preprocessor != nil {
+		input, pre = preprocess(input, preprocessor)
+	}
+
+	errors := newErrorCollector(input)
+
+	cacheMu.RLock()
+	lexer := g.NewLexer(g.NewCharStream(input))
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(errors)
+
+	tokens := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+
+	parser := g.NewParser(tokens)
+	parser.RemoveErrorListeners()
+	parser.AddErrorListener(errors)
+	if o.trace != nil {
+		addTraceListener(parser, o.trace)
+	}
+
+	tree := startRule(parser)
+
+	// Lex any input the parser didn't need, so using the Result later never
+	// adds to the caches without the lock.
+	tokens.Fill()
+	cacheMu.RUnlock()
+
+	result := &Result{
+		Grammar: g,
+		Tokens:  tokens,
+		Parser:  parser,
+		Tree:    tree,
+		Errors:  errors.errors,
+	}
+	if pre != nil {
+		pre.remap(result.Errors)
+		result.SourceMap = pre.m
+	}
+
+	if end != nil {
+		end(len(tokens.GetAllTokens()), len(errors.errors))
+	}
+	if o.metrics != nil {
+		o.metrics.ObserveParse(g, time.Since(start), len(errors.errors))
+		o.metrics.ObserveCacheSize(g, cacheSize(lexer, parser))
+	}
+
+	return result, nil
+}
+
+// Tokenize lexes the input, returning all the tokens (on every channel)
+// excluding the final EOF, and any syntax errors found by the Lexer.
+func (g *Grammar) Tokenize(input antlr.CharStream) ([]antlr.Token, []*SyntaxError) {
+	errors := newErrorCollector(input)
+
+	unlock := g.readFlags()
+	defer unlock()
+	cacheMu.RLock()
+	defer cacheMu.RUnlock()
+
+	lexer := g.NewLexer(g.NewCharStream(input))
+	lexer.RemoveErrorListeners()
+	lexer.AddErrorListener(errors)
+
+	var tokens []antlr.Token
+	for tok := lexer.NextToken(); tok.GetTokenType() != antlr.TokenEOF; tok = lexer.NextToken() {
+		tokens = append(tokens, tok)
+	}
+	return tokens, errors.errors
+}
+
+// ParseFile is the same as Parse, but reads the input from the named file.
+// The Errors, and the tokens' input stream, are a NamedStream with the
+// filename.
+func (g *Grammar) ParseFile(filename string, opts ...Option) (*Result, error) {
+	input, err := NewNamedFileStream(filename)
+	if err != nil {
+		return nil, err
+	}
+	return g.Parse(input, opts...)
+}
@@ -0,0 +1,122 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grammars
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// WithStartRule parses the input starting at the named parser rule, instead
+// of the grammar's EntryPoint. Parse returns an error if there is no such
+// rule, or it takes arguments. Most rules do not end with EOF, so any input
+// after the rule is ignored.
+func WithStartRule(rule string) Option {
+	return func(o *options) {
+		o.rule = rule
+		o.ruleSet = true
+	}
+}
+
+// WithSpan parses only the span of the input, for example to reparse a single
+// function an editor has changed. The syntax errors are reported at their
+// line and column in the whole input, and Result.SourceMap maps the tokens'
+// offsets back to it. It is typically used with WithStartRule, and a Span
+// returned by Result.Span from an earlier parse:
+//
+//	span, _ := result.Span(function, grammars.NoTrivia)
+//	g.Parse(input, grammars.WithSpan(span), grammars.WithStartRule("function"))
+//
+// If there is also a Preprocessor, it is run over just the span.
+func WithSpan(span Span) Option {
+	return func(o *options) {
+		o.span = &span
+	}
+}
+
+// parserRuleContextType is the type of antlr.ParserRuleContext, which the
+// generated parser's rule methods return.
+var parserRuleContextType = reflect.TypeOf((*antlr.ParserRuleContext)(nil)).Elem()
+
+// startRule returns a function invoking the named rule on a Parser returned
+// by NewParser, or an error if the parser has no such rule, or the rule takes
+// arguments.
+func (g *Grammar) startRule(name string) (func(parser antlr.Parser) antlr.ParserRuleContext, error) {
+	if name == "" {
+		return nil, fmt.Errorf("%s: empty rule name", g.Name)
+	}
+	if g.RuleIndex != nil {
+		if _, found := g.RuleIndex(name); !found {
+			return nil, fmt.Errorf("%s: unknown rule %q", g.Name, name)
+		}
+	}
+
+	// The generated parser has a method for each rule, named after the rule
+	// with the first letter upper cased. Check it on a parser of an empty
+	// input, so the error is returned before parsing starts.
+	method := strings.ToUpper(name[:1]) + name[1:]
+	probe := g.NewParser(antlr.NewCommonTokenStream(g.NewLexer(antlr.NewInputStream("")), antlr.TokenDefaultChannel))
+	m, found := reflect.TypeOf(probe).MethodByName(method)
+	if !found || m.Type.NumOut() != 1 || !m.Type.Out(0).Implements(parserRuleContextType) {
+		return nil, fmt.Errorf("%s: unknown rule %q", g.Name, name)
+	}
+	if m.Type.NumIn() != 1 { // The receiver
+		return nil, fmt.Errorf("%s: rule %q takes arguments", g.Name, name)
+	}
+
+	return func(parser antlr.Parser) antlr.ParserRuleContext {
+		tree, _ := reflect.ValueOf(parser).MethodByName(method).Call(nil)[0].Interface().(antlr.ParserRuleContext)
+		return tree
+	}, nil
+}
+
+// checkSpan returns an error if the span is not within an input of the
+// given size.
+func checkSpan(span Span, size int) error {
+	if span.Start < 0 || span.Stop < span.Start-1 || span.Stop >= size {
+		return fmt.Errorf("span [%d, %d] is outside the input [0, %d]", span.Start, span.Stop, size-1)
+	}
+	return nil
+}
+
+// spanPreprocessor returns a Preprocessor cutting the span out of the input,
+// and then running p over it, if not nil.
+func spanPreprocessor(span Span, p Preprocessor) Preprocessor {
+	return func(input string) (string, *SourceMap) {
+		output := string([]rune(input)[span.Start : span.Stop+1])
+
+		var m *SourceMap
+		if p != nil {
+			output, m = p(output)
+		}
+		return output, m.shift(span.Start)
+	}
+}
+
+// shift returns a copy of the SourceMap, mapping each offset n runes further
+// into the input.
+func (m *SourceMap) shift(n int) *SourceMap {
+	shifted := &SourceMap{}
+	shifted.Add(0, n)
+	if m != nil {
+		for _, s := range m.segments {
+			shifted.Add(s.out, s.in+n)
+		}
+	}
+	return shifted
+}
@@ -0,0 +1,174 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grammars
+
+import (
+	"sort"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// Preprocessor transforms the input before it is lexed, for example to join
+// continuation lines, replace trigraphs, or expand tabs. It returns the
+// transformed input, and a SourceMap from offsets in it back to the input.
+type Preprocessor func(input string) (string, *SourceMap)
+
+// WithPreprocessor runs the Preprocessor on the input before it is lexed.
+// The syntax errors are reported at their position in the original input,
+// and Result.SourceMap maps the tokens' offsets back to it.
+func WithPreprocessor(p Preprocessor) Option {
+	return func(o *options) {
+		o.preprocessor = p
+	}
+}
+
+// SourceMap maps rune offsets in a Preprocessor's output back to its input.
+// A nil SourceMap maps every offset to itself.
+type SourceMap struct {
+	segments []segment
+}
+
+type segment struct {
+	out, in int
+}
+
+// Add records that the output from offset out onwards was copied from the
+// input starting at offset in, up until the next call to Add. If the output
+// is longer than the input it replaced, the extra runes map to the input's
+// last rune. Add must be called in increasing order of out. Offsets before
+// the first call map to themselves.
+func (m *SourceMap) Add(out, in int) {
+	if n := len(m.segments); n > 0 && m.segments[n-1].out == out {
+		m.segments[n-1].in = in
+		return
+	}
+	m.segments = append(m.segments, segment{out, in})
+}
+
+// Original returns the offset in the input that the output offset was
+// copied from.
+func (m *SourceMap) Original(out int) int {
+	if m == nil {
+		return out
+	}
+	i := sort.Search(len(m.segments), func(i int) bool {
+		return m.segments[i].out > out
+	}) - 1
+	if i < 0 {
+		return out
+	}
+
+	s := m.segments[i]
+	in := s.in + out - s.out
+	if i+1 < len(m.segments) {
+		// The output was longer than the input it replaced, so clamp to
+		// the last rune of the replaced input.
+		if next := m.segments[i+1].in; in >= next && next > s.in {
+			in = next - 1
+		}
+	}
+	return in
+}
+
+// ExpandTabs returns a Preprocessor replacing tabs with spaces, up to the next
+// multiple of width columns.
+func ExpandTabs(width int) Preprocessor {
+	return func(input string) (string, *SourceMap) {
+		m := &SourceMap{}
+		var out []rune
+		column := 0
+		for in, r := range []rune(input) {
+			switch r {
+			case '\t':
+				m.Add(len(out), in)
+				for n := width - column%width; n > 0; n-- {
+					out = append(out, ' ')
+					column++
+				}
+				m.Add(len(out), in+1)
+				continue
+			case '\n':
+				column = 0
+			default:
+				column++
+			}
+			out = append(out, r)
+		}
+		return string(out), m
+	}
+}
+
+// preprocessed is the input before and after it was preprocessed.
+type preprocessed struct {
+	original []rune
+	output   []rune
+	m        *SourceMap
+}
+
+// preprocess runs p over the input, returning a new CharStream with the
+// output, keeping the name of the input if it was a NamedStream.
+func preprocess(input antlr.CharStream, p Preprocessor) (antlr.CharStream, *preprocessed) {
+	var original string
+	if input.Size() > 0 {
+		original = input.GetText(0, input.Size()-1)
+	}
+	output, m := p(original)
+
+	var stream antlr.CharStream = antlr.NewInputStream(output)
+	if s := namedStream(input); s != nil {
+		stream = NewNamedStream(stream, s.Filename, s.ID)
+	}
+
+	return stream, &preprocessed{
+		original: []rune(original),
+		output:   []rune(output),
+		m:        m,
+	}
+}
+
+// remap moves the errors from their position in the output, to their
+// position in the original input.
+func (p *preprocessed) remap(errors []*SyntaxError) {
+	for _, e := range errors {
+		offset := p.m.Original(offsetOf(p.output, e.Line, e.Column))
+		e.Line, e.Column = positionOf(p.original, offset)
+	}
+}
+
+// offsetOf returns the rune offset of the line (starting at 1) and column
+// (starting at 0) in text.
+func offsetOf(text []rune, line, column int) int {
+	offset := 0
+	for l := 1; l < line && offset < len(text); offset++ {
+		if text[offset] == '\n' {
+			l++
+		}
+	}
+	return offset + column
+}
+
+// positionOf returns the line (starting at 1) and column (starting at 0) of
+// the rune offset in text.
+func positionOf(text []rune, offset int) (line, column int) {
+	line = 1
+	for i := 0; i < offset && i < len(text); i++ {
+		column++
+		if text[i] == '\n' {
+			line++
+			column = 0
+		}
+	}
+	return line, column
+}
@@ -0,0 +1,115 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grammars_test
+
+import (
+	"strings"
+	"testing"
+
+	"bramp.net/antlr4/grammars"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+func TestExpandTabs(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+		// offsets maps output offsets to input offsets
+		offsets map[int]int
+	}{
+		{"abc", "abc", map[int]int{0: 0, 2: 2}},
+		{"a\tb", "a   b", map[int]int{0: 0, 1: 1, 3: 1, 4: 2}},
+		{"\t\nab\tc", "    \nab  c", map[int]int{0: 0, 2: 0, 4: 1, 5: 2, 6: 3, 7: 4, 8: 4, 9: 5}},
+	}
+
+	for _, test := range tests {
+		got, m := grammars.ExpandTabs(4)(test.input)
+		if got != test.want {
+			t.Errorf("ExpandTabs(4)(%q) = %q, want %q", test.input, got, test.want)
+		}
+		for out, in := range test.offsets {
+			if got := m.Original(out); got != in {
+				t.Errorf("ExpandTabs(4)(%q) Original(%d) = %d, want %d", test.input, out, got, in)
+			}
+		}
+	}
+}
+
+func TestParsePreprocessor(t *testing.T) {
+	g := grammars.Lookup("json")
+
+	input := grammars.NewNamedStream(antlr.NewInputStream("{\"a\":\t}"), "a.json", "")
+	result, err := g.Parse(input, grammars.WithPreprocessor(grammars.ExpandTabs(8)))
+	if err != nil {
+		t.Fatalf("Parse(...) = %s", err)
+	}
+
+	if len(result.Errors) != 1 {
+		t.Fatalf("Parse(...) got %d errors, want 1: %v", len(result.Errors), result.Errors)
+	}
+	if got, want := result.Errors[0].Error(), "a.json:1:6"; !strings.HasPrefix(got, want) {
+		t.Errorf("Parse(...).Errors[0] = %q, want prefix %q", got, want)
+	}
+	if result.SourceMap == nil {
+		t.Errorf("Parse(...).SourceMap = nil, want the tab expansion")
+	}
+}
+
+func TestParseSpan(t *testing.T) {
+	g := grammars.Lookup("json")
+
+	// Parse just the object on the second line, with the error at the "}".
+	input := grammars.NewNamedStream(antlr.NewInputStream("[1,\n  2, {\"a\": }]"), "a.json", "")
+	span := grammars.Span{Start: 9, Stop: 15}
+	result, err := g.Parse(input, grammars.WithSpan(span), grammars.WithStartRule("obj"))
+	if err != nil {
+		t.Fatalf("Parse(...) = %s", err)
+	}
+
+	if len(result.Errors) != 1 {
+		t.Fatalf("Parse(...) got %d errors, want 1: %v", len(result.Errors), result.Errors)
+	}
+	if got, want := result.Errors[0].Error(), "a.json:2:11"; !strings.HasPrefix(got, want) {
+		t.Errorf("Parse(...).Errors[0] = %q, want prefix %q", got, want)
+	}
+	if got, want := result.SourceMap.Original(0), span.Start; got != want {
+		t.Errorf("Parse(...).SourceMap.Original(0) = %d, want %d", got, want)
+	}
+}
+
+func TestParseSpanErrors(t *testing.T) {
+	g := grammars.Lookup("json")
+	input := antlr.NewInputStream("{}")
+
+	if _, err := g.Parse(input, grammars.WithStartRule("missing")); err == nil {
+		t.Errorf("Parse(..., WithStartRule(%q)) = nil, want error", "missing")
+	}
+	if _, err := g.Parse(input, grammars.WithStartRule("")); err == nil {
+		t.Errorf("Parse(..., WithStartRule(%q)) = nil, want error", "")
+	}
+
+	// Without RuleIndex, the parser's methods are checked instead.
+	noIndex := *g
+	noIndex.RuleIndex = nil
+	for _, rule := range []string{"missing", "getRuleNames", "enterRule"} {
+		if _, err := noIndex.Parse(input, grammars.WithStartRule(rule)); err == nil {
+			t.Errorf("Parse(..., WithStartRule(%q)) without RuleIndex = nil, want error", rule)
+		}
+	}
+
+	if _, err := g.Parse(input, grammars.WithSpan(grammars.Span{Start: 1, Stop: 2})); err == nil {
+		t.Errorf("Parse(..., WithSpan([1, 2])) = nil, want error")
+	}
+}
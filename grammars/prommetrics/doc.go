@@ -0,0 +1,27 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package prommetrics exports parsing metrics to Prometheus, labelled by
+// grammar:
+//
+//	m := prommetrics.New()
+//	prometheus.MustRegister(m)
+//
+//	result, err := g.Parse(input, grammars.WithMetrics(m))
+//
+// Each grammar adds one series per metric, so the cardinality is bounded by
+// the number of grammars parsed. Recent releases of client_golang no longer
+// build with Go 1.8, so Metrics is only defined when building with Go 1.17 or
+// later.
+package prommetrics // import "bramp.net/antlr4/grammars/prommetrics"
@@ -0,0 +1,99 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.17
+// +build go1.17
+
+package prommetrics
+
+import (
+	"time"
+
+	"bramp.net/antlr4/grammars"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Namespace prefixes the name of every metric.
+const Namespace = "antlr4"
+
+// Metrics is a grammars.Metrics, and prometheus.Collector, recording:
+//
+//	antlr4_parses_total{grammar}
+//	antlr4_parse_failures_total{grammar}
+//	antlr4_parse_duration_seconds{grammar}
+//	antlr4_dfa_states{grammar}
+type Metrics struct {
+	parses   *prometheus.CounterVec
+	failures *prometheus.CounterVec
+	duration *prometheus.HistogramVec
+	states   *prometheus.GaugeVec
+}
+
+// New returns Metrics, which must be registered before use.
+func New() *Metrics {
+	labels := []string{"grammar"}
+	return &Metrics{
+		parses: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "parses_total",
+			Help:      "Number of inputs parsed.",
+		}, labels),
+		failures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: Namespace,
+			Name:      "parse_failures_total",
+			Help:      "Number of inputs parsed with syntax errors.",
+		}, labels),
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: Namespace,
+			Name:      "parse_duration_seconds",
+			Help:      "Time taken to lex and parse each input.",
+			Buckets:   prometheus.ExponentialBuckets(0.0001, 4, 10), // 100µs to ~26s
+		}, labels),
+		states: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: Namespace,
+			Name:      "dfa_states",
+			Help:      "Number of DFA states cached by the lexer and parser.",
+		}, labels),
+	}
+}
+
+// Describe implements prometheus.Collector.
+func (m *Metrics) Describe(ch chan<- *prometheus.Desc) {
+	m.parses.Describe(ch)
+	m.failures.Describe(ch)
+	m.duration.Describe(ch)
+	m.states.Describe(ch)
+}
+
+// Collect implements prometheus.Collector.
+func (m *Metrics) Collect(ch chan<- prometheus.Metric) {
+	m.parses.Collect(ch)
+	m.failures.Collect(ch)
+	m.duration.Collect(ch)
+	m.states.Collect(ch)
+}
+
+// ObserveParse counts the parse, and if there were syntax errors, a failure.
+func (m *Metrics) ObserveParse(g *grammars.Grammar, duration time.Duration, errors int) {
+	m.parses.WithLabelValues(g.Name).Inc()
+	if errors > 0 {
+		m.failures.WithLabelValues(g.Name).Inc()
+	}
+	m.duration.WithLabelValues(g.Name).Observe(duration.Seconds())
+}
+
+// ObserveCacheSize records the latest number of cached DFA states.
+func (m *Metrics) ObserveCacheSize(g *grammars.Grammar, states int) {
+	m.states.WithLabelValues(g.Name).Set(float64(states))
+}
@@ -0,0 +1,60 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build go1.17
+// +build go1.17
+
+package prommetrics_test
+
+import (
+	"strings"
+	"testing"
+
+	"bramp.net/antlr4/grammars"
+	"bramp.net/antlr4/grammars/prommetrics"
+	_ "bramp.net/antlr4/json"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestMetrics(t *testing.T) {
+	m := prommetrics.New()
+	registry := prometheus.NewPedanticRegistry()
+	registry.MustRegister(m)
+
+	g := grammars.Lookup("json")
+	for _, input := range []string{`{"a": [1, 2]}`, `{"a": [1, 2}`, `[]`} {
+		if _, err := g.Parse(antlr.NewInputStream(input), grammars.WithMetrics(m)); err != nil {
+			t.Fatalf("Parse(%q) err = %s, want nil", input, err)
+		}
+	}
+
+	const want = `
+# HELP antlr4_parses_total Number of inputs parsed.
+# TYPE antlr4_parses_total counter
+antlr4_parses_total{grammar="json"} 3
+# HELP antlr4_parse_failures_total Number of inputs parsed with syntax errors.
+# TYPE antlr4_parse_failures_total counter
+antlr4_parse_failures_total{grammar="json"} 1
+`
+	if err := testutil.GatherAndCompare(registry, strings.NewReader(want), "antlr4_parses_total", "antlr4_parse_failures_total"); err != nil {
+		t.Errorf("Parse(...) metrics differ: %s", err)
+	}
+
+	// The durations vary, so only check the histogram exists.
+	if got := testutil.CollectAndCount(m, "antlr4_parse_duration_seconds"); got != 1 {
+		t.Errorf("Parse(...) recorded %d antlr4_parse_duration_seconds series, want 1", got)
+	}
+}
@@ -0,0 +1,435 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package query
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+type itemType int
+
+const (
+	itemEOF       itemType = iota
+	itemOpen               // (
+	itemClose              // )
+	itemWord               // rule or token name, or _
+	itemLiteral            // 'literal'
+	itemString             // "string"
+	itemCapture            // @name
+	itemPredicate          // #name
+)
+
+// item is a token of the query language.
+type item struct {
+	typ    itemType
+	text   string
+	offset int
+}
+
+func (i item) String() string {
+	if i.typ == itemEOF {
+		return "end of query"
+	}
+	return strconv.Quote(i.text)
+}
+
+// lex splits the query into items, ending with itemEOF.
+func lex(source string) ([]item, error) {
+	var items []item
+	for i := 0; i < len(source); {
+		c := source[i]
+		start := i
+		switch {
+		case c == ' ' || c == '\t' || c == '\r' || c == '\n':
+			i++
+			continue
+
+		case c == ';':
+			for i < len(source) && source[i] != '\n' {
+				i++
+			}
+			continue
+
+		case c == '(':
+			items = append(items, item{itemOpen, "(", start})
+			i++
+
+		case c == ')':
+			items = append(items, item{itemClose, ")", start})
+			i++
+
+		case c == '"' || c == '\'':
+			i++
+			for i < len(source) && source[i] != c {
+				if source[i] == '\\' {
+					i++
+				}
+				i++
+			}
+			if i >= len(source) {
+				return nil, fmt.Errorf("query: unterminated %c at offset %d", c, start)
+			}
+			i++
+
+			if c == '\'' {
+				items = append(items, item{itemLiteral, source[start:i], start})
+				break
+			}
+			s, err := strconv.Unquote(source[start:i])
+			if err != nil {
+				return nil, fmt.Errorf("query: invalid string %s at offset %d", source[start:i], start)
+			}
+			items = append(items, item{itemString, s, start})
+
+		case c == '@' || c == '#' || isIdentifier(c):
+			i++
+			for i < len(source) && (isIdentifier(source[i]) || isDigit(source[i]) || source[i] == '-' || source[i] == '?' || source[i] == '.') {
+				i++
+			}
+			typ := itemWord
+			switch c {
+			case '@':
+				typ = itemCapture
+			case '#':
+				typ = itemPredicate
+			}
+			if typ != itemWord && i-start == 1 {
+				return nil, fmt.Errorf("query: missing name after %c at offset %d", c, start)
+			}
+			items = append(items, item{typ, source[start:i], start})
+
+		default:
+			return nil, fmt.Errorf("query: invalid character %q at offset %d", c, start)
+		}
+	}
+	return append(items, item{itemEOF, "", len(source)}), nil
+}
+
+// parser compiles the items into patterns.
+type parser struct {
+	items      []item
+	pos        int
+	recognizer antlr.Recognizer
+
+	predicates []*predicate // Predicates found in the current top level pattern
+	captures   map[string]bool
+}
+
+func (p *parser) peek(n int) item {
+	if p.pos+n < len(p.items) {
+		return p.items[p.pos+n]
+	}
+	return p.items[len(p.items)-1]
+}
+
+func (p *parser) next() item {
+	i := p.peek(0)
+	if p.pos < len(p.items)-1 {
+		p.pos++
+	}
+	return i
+}
+
+func (p *parser) errorf(i item, format string, args ...interface{}) error {
+	return fmt.Errorf("query: %s at offset %d", fmt.Sprintf(format, args...), i.offset)
+}
+
+// Compile parses a query, looking up the rule and token names with the
+// recognizer (normally the Parser that built the tree).
+func Compile(source string, recognizer antlr.Recognizer) (*Query, error) {
+	items, err := lex(source)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{
+		items:      items,
+		recognizer: recognizer,
+		captures:   make(map[string]bool),
+	}
+	q := &Query{source: source}
+
+	for p.peek(0).typ != itemEOF {
+		p.predicates = nil
+		pat, err := p.parsePattern()
+		if err != nil {
+			return nil, err
+		}
+		q.patterns = append(q.patterns, &topPattern{
+			pattern:    pat,
+			predicates: p.predicates,
+		})
+	}
+	if len(q.patterns) == 0 {
+		return nil, fmt.Errorf("query: no patterns")
+	}
+
+	// Record the capture names in the order they appear.
+	for _, i := range items {
+		if i.typ == itemCapture && p.captures[i.text[1:]] {
+			q.captures = append(q.captures, i.text[1:])
+			delete(p.captures, i.text[1:])
+		}
+	}
+	return q, nil
+}
+
+// MustCompile is like Compile but panics if the query cannot be compiled.
+func MustCompile(source string, recognizer antlr.Recognizer) *Query {
+	q, err := Compile(source, recognizer)
+	if err != nil {
+		panic(err)
+	}
+	return q
+}
+
+// parsePattern parses a node, followed by its captures.
+func (p *parser) parsePattern() (*pattern, error) {
+	var pat *pattern
+	var err error
+
+	switch i := p.next(); i.typ {
+	case itemWord, itemLiteral:
+		pat, err = p.resolve(i)
+
+	case itemOpen:
+		pat, err = p.parseList(i)
+
+	default:
+		err = p.errorf(i, "expected a pattern, found %s", i)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek(0).typ == itemCapture {
+		name := p.next().text[1:]
+		pat.captures = append(pat.captures, name)
+		p.captures[name] = true
+	}
+	return pat, nil
+}
+
+// parseList parses the rest of a parenthesised pattern, after the open.
+func (p *parser) parseList(open item) (*pattern, error) {
+	var pat *pattern
+	var err error
+	grouped := false
+
+	switch i := p.peek(0); i.typ {
+	case itemOpen:
+		// A group, e.g ((pair) @p (#eq? @p "..."))
+		if p.peek(1).typ == itemPredicate {
+			return nil, p.errorf(i, "predicate outside of a pattern")
+		}
+		if pat, err = p.parsePattern(); err != nil {
+			return nil, err
+		}
+		grouped = true
+
+	case itemWord, itemLiteral:
+		p.next()
+		if pat, err = p.resolve(i); err != nil {
+			return nil, err
+		}
+		if pat.kind == anyNode {
+			pat.kind = anyRule
+		}
+
+	default:
+		return nil, p.errorf(i, "expected a rule or token name, found %s", i)
+	}
+
+	for {
+		switch i := p.peek(0); {
+		case i.typ == itemClose:
+			p.next()
+			return pat, nil
+
+		case i.typ == itemOpen && p.peek(1).typ == itemPredicate:
+			p.next()
+			if err := p.parsePredicate(); err != nil {
+				return nil, err
+			}
+
+		case i.typ == itemEOF:
+			return nil, p.errorf(open, "unclosed (")
+
+		default:
+			if grouped {
+				return nil, p.errorf(i, "unexpected %s, a group may only contain one pattern and its predicates", i)
+			}
+			if pat.kind == token {
+				return nil, p.errorf(i, "unexpected %s, only rules may have child patterns", i)
+			}
+			child, err := p.parsePattern()
+			if err != nil {
+				return nil, err
+			}
+			pat.children = append(pat.children, child)
+		}
+	}
+}
+
+// resolve looks up the rule index or token type for the name.
+func (p *parser) resolve(i item) (*pattern, error) {
+	name := i.text
+	switch {
+	case name == "_":
+		return &pattern{kind: anyNode}, nil
+
+	case i.typ == itemLiteral:
+		tokenType := indexOf(p.recognizer.GetLiteralNames(), name)
+		if tokenType < 0 {
+			return nil, p.errorf(i, "%s isn't a valid literal token", name)
+		}
+		return &pattern{kind: token, index: tokenType}, nil
+
+	case isUpper(name[0]):
+		tokenType := indexOf(p.recognizer.GetSymbolicNames(), name)
+		if tokenType < 0 {
+			return nil, p.errorf(i, "%s isn't a valid token name", name)
+		}
+		return &pattern{kind: token, index: tokenType}, nil
+	}
+
+	ruleIndex := indexOf(p.recognizer.GetRuleNames(), name)
+	if ruleIndex < 0 {
+		return nil, p.errorf(i, "%s isn't a valid rule name", name)
+	}
+	return &pattern{kind: rule, index: ruleIndex}, nil
+}
+
+// predicate filters matches by the text of their captures.
+type predicate struct {
+	name    string
+	capture string
+	other   string // Name of the capture to compare with, or empty
+	text    string
+	re      *regexp.Regexp
+	negate  bool
+}
+
+// parsePredicate parses the rest of a predicate, after the open.
+func (p *parser) parsePredicate() error {
+	start := p.next()
+	pred := &predicate{name: start.text}
+
+	var args []item
+	for p.peek(0).typ == itemCapture || p.peek(0).typ == itemString {
+		args = append(args, p.next())
+	}
+	if i := p.next(); i.typ != itemClose {
+		return p.errorf(i, "expected ) after %s arguments, found %s", pred.name, i)
+	}
+	if len(args) != 2 || args[0].typ != itemCapture {
+		return p.errorf(start, "%s expects a capture, and a string or capture", pred.name)
+	}
+	pred.capture = args[0].text[1:]
+
+	switch pred.name {
+	case "#eq?", "#not-eq?":
+		pred.negate = strings.HasPrefix(pred.name, "#not-")
+		if args[1].typ == itemCapture {
+			pred.other = args[1].text[1:]
+		} else {
+			pred.text = args[1].text
+		}
+
+	case "#match?", "#not-match?":
+		pred.negate = strings.HasPrefix(pred.name, "#not-")
+		if args[1].typ != itemString {
+			return p.errorf(args[1], "%s expects a regular expression string", pred.name)
+		}
+		re, err := regexp.Compile(args[1].text)
+		if err != nil {
+			return p.errorf(args[1], "%s", err)
+		}
+		pred.re = re
+
+	default:
+		return p.errorf(start, "unknown predicate %s", pred.name)
+	}
+
+	p.predicates = append(p.predicates, pred)
+	return nil
+}
+
+// check returns true if the captures satisfy all the pattern's predicates.
+func (p *topPattern) check(captures []Capture) bool {
+	for _, pred := range p.predicates {
+		if !pred.check(captures) {
+			return false
+		}
+	}
+	return true
+}
+
+func (pred *predicate) check(captures []Capture) bool {
+	text, ok := captureText(captures, pred.capture)
+	if !ok {
+		return false
+	}
+
+	var match bool
+	switch {
+	case pred.re != nil:
+		match = pred.re.MatchString(text)
+	case pred.other != "":
+		other, ok := captureText(captures, pred.other)
+		if !ok {
+			return false
+		}
+		match = text == other
+	default:
+		match = text == pred.text
+	}
+	return match != pred.negate
+}
+
+func captureText(captures []Capture, name string) (string, bool) {
+	for _, c := range captures {
+		if c.Name == name {
+			return c.Node.GetText(), true
+		}
+	}
+	return "", false
+}
+
+func indexOf(names []string, name string) int {
+	for i, n := range names {
+		if n == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func isUpper(c byte) bool {
+	return 'A' <= c && c <= 'Z'
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func isIdentifier(c byte) bool {
+	return c == '_' || ('a' <= c && c <= 'z') || isUpper(c)
+}
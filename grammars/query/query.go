@@ -0,0 +1,191 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package query matches patterns against parse trees, using a small
+// S-expression language modelled on tree-sitter's queries.
+//
+// A pattern is a rule name in parentheses, followed by patterns for some of
+// its children. The children must appear in the same order, but may have
+// other nodes between them. A token is matched by its name, or its literal
+// text in single quotes. "_" matches any node, and "(_)" any rule. For
+// example, with the JSON grammar:
+//
+//	(pair STRING @key (value (array)))   pairs whose value is an array
+//	(array '[' (value 'true') @first)    arrays containing true
+//
+// Appending @name to a pattern captures the matched node. Predicates filter
+// matches by the text of the captured nodes:
+//
+//	((pair STRING @key) (#eq? @key "\"id\""))
+//	((pair STRING @key) (#match? @key "^\"x-"))
+//
+// The supported predicates are #eq?, #not-eq?, #match? and #not-match?, whose
+// second argument is either a string or another capture. A capture's text
+// is the text of its tokens, as returned by GetText, so excludes any hidden
+// tokens, such as whitespace.
+//
+// Text after a ";" is a comment, until the end of the line.
+package query // import "bramp.net/antlr4/grammars/query"
+
+import (
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// Query is a compiled list of patterns.
+type Query struct {
+	source   string
+	patterns []*topPattern
+	captures []string
+}
+
+// Capture is a node captured by a @name in the pattern.
+type Capture struct {
+	Name string
+	Node antlr.ParseTree
+}
+
+// Match is a match of one of the query's patterns.
+type Match struct {
+	Pattern  int // Index of the pattern in the query
+	Captures []Capture
+}
+
+// Capture returns the first node captured with this name, or nil if none.
+func (m *Match) Capture(name string) antlr.ParseTree {
+	for _, c := range m.Captures {
+		if c.Name == name {
+			return c.Node
+		}
+	}
+	return nil
+}
+
+type kind int
+
+const (
+	anyNode kind = iota // _
+	anyRule             // (_)
+	rule
+	token
+)
+
+// pattern matches a node, and optionally some of its children.
+type pattern struct {
+	kind     kind
+	index    int // Rule index or token type
+	children []*pattern
+	captures []string
+}
+
+// topPattern is one of the query's patterns, with the predicates that apply
+// to its matches.
+type topPattern struct {
+	*pattern
+	predicates []*predicate
+}
+
+func (p *pattern) matches(t antlr.Tree) bool {
+	switch n := t.(type) {
+	case antlr.RuleContext:
+		return p.kind == anyNode || p.kind == anyRule || (p.kind == rule && n.GetRuleIndex() == p.index)
+	case antlr.TerminalNode:
+		return p.kind == anyNode || (p.kind == token && n.GetSymbol().GetTokenType() == p.index)
+	}
+	return false
+}
+
+// match calls k with the captures for each way the pattern matches t, until k
+// returns true.
+func (p *pattern) match(t antlr.Tree, captures []Capture, k func([]Capture) bool) bool {
+	if !p.matches(t) {
+		return false
+	}
+	for _, name := range p.captures {
+		// Copy, so backtracking doesn't clobber another branch's captures.
+		captures = append(captures[:len(captures):len(captures)], Capture{
+			Name: name,
+			Node: t.(antlr.ParseTree),
+		})
+	}
+	return matchChildren(p.children, t.GetChildren(), captures, k)
+}
+
+// matchChildren matches the patterns in order against the children, allowing
+// unmatched children between them.
+func matchChildren(patterns []*pattern, children []antlr.Tree, captures []Capture, k func([]Capture) bool) bool {
+	if len(patterns) == 0 {
+		return k(captures)
+	}
+	for i, c := range children {
+		rest := children[i+1:]
+		if patterns[0].match(c, captures, func(captures []Capture) bool {
+			return matchChildren(patterns[1:], rest, captures, k)
+		}) {
+			return true
+		}
+	}
+	return false
+}
+
+// Matches returns the matches in the tree, in the order the matched nodes
+// are visited by a depth first walk. Each pattern matches a node at most
+// once.
+func (q *Query) Matches(t antlr.ParseTree) []*Match {
+	var matches []*Match
+	var walk func(n antlr.Tree)
+	walk = func(n antlr.Tree) {
+		for i, p := range q.patterns {
+			p.match(n, nil, func(captures []Capture) bool {
+				if !p.check(captures) {
+					return false
+				}
+				matches = append(matches, &Match{
+					Pattern:  i,
+					Captures: captures,
+				})
+				return true
+			})
+		}
+		for _, c := range n.GetChildren() {
+			walk(c)
+		}
+	}
+	walk(t)
+	return matches
+}
+
+// Captures returns every captured node in the tree, in the order they were
+// matched.
+func (q *Query) Captures(t antlr.ParseTree) []Capture {
+	var captures []Capture
+	for _, m := range q.Matches(t) {
+		captures = append(captures, m.Captures...)
+	}
+	return captures
+}
+
+// CaptureNames returns the names of the captures in the query, without the
+// "@", in the order they first appear.
+func (q *Query) CaptureNames() []string {
+	return q.captures
+}
+
+// PatternCount returns the number of patterns in the query.
+func (q *Query) PatternCount() int {
+	return len(q.patterns)
+}
+
+func (q *Query) String() string {
+	return q.source
+}
@@ -0,0 +1,127 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package query
+
+import (
+	"fmt"
+	"testing"
+
+	"bramp.net/antlr4/json"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/kylelemons/godebug/pretty"
+)
+
+const input = `{"a": 1, "b": [true, {"c": "d"}], "e": null}`
+
+func parse(input string) (*json.JSONParser, antlr.ParseTree) {
+	lexer := json.NewJSONLexer(antlr.NewInputStream(input))
+	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	p := json.NewJSONParser(stream)
+	return p, p.Json()
+}
+
+func TestMatches(t *testing.T) {
+	tests := []struct {
+		query string
+		want  []string // pattern:name=text for each capture
+	}{
+		{`(pair STRING @key)`, []string{`0:key="a"`, `0:key="b"`, `0:key="c"`, `0:key="e"`}},
+		{`(pair STRING @key (value (array)))`, []string{`0:key="b"`}},
+		{`(array (value 'true') @first) ; a comment`, []string{`0:first=true`}},
+		{`(_ 'null' @n)`, []string{`0:n=null`}},
+		{`(obj (pair) @a (pair) @b)`, []string{`0:a="a":1`, `0:b="b":[true,{"c":"d"}]`}},
+		{
+			`(pair STRING @k (value STRING @v))
+			 (pair STRING @k (value 'null'))`,
+			[]string{`0:k="c"`, `0:v="d"`, `1:k="e"`},
+		},
+
+		// Predicates
+		{`((pair STRING @key) (#eq? @key "\"e\""))`, []string{`0:key="e"`}},
+		{`((pair STRING @key) (#not-eq? @key "\"e\""))`, []string{`0:key="a"`, `0:key="b"`, `0:key="c"`}},
+		{`((pair STRING @key) (#match? @key "^\"[ab]\""))`, []string{`0:key="a"`, `0:key="b"`}},
+		{`((pair STRING @key) (#not-match? @key "^\"[ab]\""))`, []string{`0:key="c"`, `0:key="e"`}},
+		{`((pair STRING @k (value _ @v)) (#eq? @k @v))`, nil},
+		{`((pair STRING @k (value _ @v)) (#not-eq? @k @v))`, []string{
+			`0:k="a"`, `0:v=1`, `0:k="b"`, `0:v=[true,{"c":"d"}]`, `0:k="c"`, `0:v="d"`, `0:k="e"`, `0:v=null`,
+		}},
+	}
+
+	for _, test := range tests {
+		p, tree := parse(input)
+		q, err := Compile(test.query, p)
+		if err != nil {
+			t.Errorf("Compile(%q) err = %s, want nil", test.query, err)
+			continue
+		}
+
+		var got []string
+		for _, m := range q.Matches(tree) {
+			for _, c := range m.Captures {
+				got = append(got, fmt.Sprintf("%d:%s=%s", m.Pattern, c.Name, c.Node.GetText()))
+			}
+		}
+
+		if diff := pretty.Compare(got, test.want); diff != "" {
+			t.Errorf("Compile(%q).Matches() diff: (-got +want)\n%s", test.query, diff)
+		}
+	}
+}
+
+func TestCaptureNames(t *testing.T) {
+	const query = `(pair STRING @key (value) @val) (obj) @key`
+
+	p, _ := parse(input)
+	q := MustCompile(query, p)
+
+	want := []string{"key", "val"}
+	if diff := pretty.Compare(q.CaptureNames(), want); diff != "" {
+		t.Errorf("Compile(%q).CaptureNames() diff: (-got +want)\n%s", query, diff)
+	}
+	if got := q.PatternCount(); got != 2 {
+		t.Errorf("Compile(%q).PatternCount() = %d, want 2", query, got)
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	tests := []string{
+		``,
+		`(`,
+		`(pair`,
+		`)`,
+		`(nope)`,
+		`(NOPE)`,
+		`('nope')`,
+		`(pair) @`,
+		`(pair) $`,
+		`(pair "string")`,
+		`(STRING (pair))`,
+		`((pair) (obj))`,
+		`"unterminated`,
+		`(#eq? @a "b")`,
+		`((pair) @a (#eq? @a))`,
+		`((pair) @a (#eq? "a" "b"))`,
+		`((pair) @a (#unknown? @a "b"))`,
+		`((pair) @a (#match? @a "("))`,
+		`((pair) @a (#match? @a @a))`,
+	}
+
+	p, _ := parse(input)
+	for _, test := range tests {
+		if _, err := Compile(test, p); err == nil {
+			t.Errorf("Compile(%q) err = nil, want error", test)
+		}
+	}
+}
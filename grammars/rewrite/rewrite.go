@@ -0,0 +1,202 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package rewrite replaces nodes of a parse tree in the original input, while
+// preserving all the other text (such as whitespace and comments) exactly as
+// it was. Combined with the xpath package, this allows structural search and
+// replace of any grammar.
+package rewrite // import "bramp.net/antlr4/grammars/rewrite"
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"sort"
+	"text/template"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// Rewriter records the replacements to make to the input.
+type Rewriter struct {
+	input      antlr.CharStream
+	recognizer antlr.Recognizer
+	edits      []edit
+}
+
+// edit replaces the characters from start to stop (inclusive) with text. If
+// stop is before start, the text is inserted at start.
+type edit struct {
+	start, stop int
+	text        string
+}
+
+// insertion returns true if the edit inserts text, instead of replacing it.
+func (e edit) insertion() bool {
+	return e.stop < e.start
+}
+
+func (e edit) overlaps(o edit) bool {
+	// An insertion at the start of a replacement could go either side of it.
+	if e.start == o.start && e.insertion() != o.insertion() {
+		return true
+	}
+	return e.start <= o.stop && o.start <= e.stop
+}
+
+// New returns a Rewriter for the input that was parsed by the recognizer.
+func New(input antlr.CharStream, recognizer antlr.Recognizer) *Rewriter {
+	return &Rewriter{
+		input:      input,
+		recognizer: recognizer,
+	}
+}
+
+// errNotInInput is returned for a node that has no source text to replace.
+var errNotInInput = errors.New("rewrite: node is not in the input")
+
+// inInput returns true if the token was read from the input, and was not
+// conjured up by the parser's error recovery.
+func inInput(t antlr.Token) bool {
+	return t.GetStart() >= 0 && t.GetTokenIndex() >= 0
+}
+
+// interval returns the index of the first and last character of the node.
+func interval(node antlr.ParseTree) (int, int, error) {
+	switch n := node.(type) {
+	case antlr.ParserRuleContext:
+		start, stop := n.GetStart(), n.GetStop()
+		if start == nil {
+			return 0, 0, fmt.Errorf("rewrite: node %T has no start token", node)
+		}
+		if !inInput(start) {
+			return 0, 0, errNotInInput
+		}
+		if stop == nil || stop.GetTokenIndex() < start.GetTokenIndex() {
+			// A empty rule, so insert at the start.
+			return start.GetStart(), start.GetStart() - 1, nil
+		}
+		if !inInput(stop) {
+			return 0, 0, errNotInInput
+		}
+		return start.GetStart(), stop.GetStop(), nil
+
+	case antlr.TerminalNode:
+		if !inInput(n.GetSymbol()) {
+			return 0, 0, errNotInInput
+		}
+		return n.GetSymbol().GetStart(), n.GetSymbol().GetStop(), nil
+	}
+	return 0, 0, fmt.Errorf("rewrite: unsupported node %T", node)
+}
+
+// Replace replaces the source text of the node with text. It is an error to
+// replace a node that overlaps a previously replaced node, or that is not in
+// the input, such as a token conjured up by the parser's error recovery.
+func (r *Rewriter) Replace(node antlr.ParseTree, text string) error {
+	start, stop, err := interval(node)
+	if err != nil {
+		return err
+	}
+
+	e := edit{start: start, stop: stop, text: text}
+	for _, o := range r.edits {
+		if e.overlaps(o) {
+			return fmt.Errorf("rewrite: replacement at %d-%d overlaps previous replacement at %d-%d", e.start, e.stop, o.start, o.stop)
+		}
+	}
+
+	r.edits = append(r.edits, e)
+	return nil
+}
+
+// ReplaceAll executes the template for each node, with a *Match as data, and
+// replaces the node with the output. Nodes that overlap an earlier replacement
+// (such as a node nested inside a previously replaced one), or are not in the
+// input, are skipped.
+func (r *Rewriter) ReplaceAll(nodes []antlr.ParseTree, tmpl *template.Template) error {
+	for _, node := range nodes {
+		start, stop, err := interval(node)
+		if err == errNotInInput {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if r.overlaps(edit{start: start, stop: stop}) {
+			continue
+		}
+
+		var out bytes.Buffer
+		if err := tmpl.Execute(&out, &Match{Node: node, r: r}); err != nil {
+			return err
+		}
+
+		if err := r.Replace(node, out.String()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (r *Rewriter) overlaps(e edit) bool {
+	for _, o := range r.edits {
+		if e.overlaps(o) {
+			return true
+		}
+	}
+	return false
+}
+
+// source returns the original text of the node.
+func (r *Rewriter) source(node antlr.ParseTree) string {
+	start, stop, err := interval(node)
+	if err != nil || stop < start {
+		return ""
+	}
+	return r.input.GetText(start, stop)
+}
+
+// Text returns the input with all the replacements applied.
+func (r *Rewriter) Text() string {
+	input := []rune(r.input.GetText(0, r.input.Size()-1))
+
+	// Keep insertions at the same offset in the order they were added.
+	edits := append([]edit(nil), r.edits...)
+	sort.SliceStable(edits, func(i, j int) bool {
+		return edits[i].start < edits[j].start
+	})
+
+	var out bytes.Buffer
+	last := 0
+	for _, e := range edits {
+		if last < e.start {
+			out.WriteString(string(input[last:e.start]))
+			last = e.start
+		}
+		out.WriteString(e.text)
+		if last < e.stop+1 {
+			last = e.stop + 1
+		}
+	}
+	out.WriteString(string(input[last:]))
+
+	return out.String()
+}
+
+// Changed returns true if any replacements have been made.
+func (r *Rewriter) Changed() bool {
+	return len(r.edits) > 0
+}
@@ -0,0 +1,182 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rewrite
+
+import (
+	"testing"
+
+	"bramp.net/antlr4/grammars/xpath"
+	"bramp.net/antlr4/json"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+func TestReplaceAll(t *testing.T) {
+	const input = `{"a" : 1,
+  "b": [null, {"c":  null}],
+  "d":"e"}`
+
+	tests := []struct {
+		path     string
+		template string
+		want     string
+	}{
+		{
+			path:     "//value/'null'",
+			template: "0",
+			want: `{"a" : 1,
+  "b": [0, {"c":  0}],
+  "d":"e"}`,
+		}, {
+			path:     "//pair/STRING",
+			template: `{{ .Text | upper }}`,
+			want: `{"A" : 1,
+  "B": [null, {"C":  null}],
+  "D":"e"}`,
+		}, {
+			// Nested pairs are skipped, as the outer pair has already been replaced.
+			path:     "//pair",
+			template: `{{ .Child "value" }}:{{ .Child "STRING" }}`,
+			want: `{1:"a",
+  [null, {"c":  null}]:"b",
+  "e":"d"}`,
+		}, {
+			path:     "//array/value",
+			template: `{{ .Children "obj" | len }}`,
+			want: `{"a" : 1,
+  "b": [0, 1],
+  "d":"e"}`,
+		},
+	}
+
+	for _, test := range tests {
+		is := antlr.NewInputStream(input)
+		p := json.NewJSONParser(antlr.NewCommonTokenStream(json.NewJSONLexer(is), antlr.TokenDefaultChannel))
+		tree := p.Json()
+
+		nodes, err := xpath.FindAll(tree, test.path, p)
+		if err != nil {
+			t.Errorf("FindAll(%q) err = %s, want nil", test.path, err)
+			continue
+		}
+
+		tmpl, err := NewTemplate(test.template)
+		if err != nil {
+			t.Errorf("NewTemplate(%q) err = %s, want nil", test.template, err)
+			continue
+		}
+
+		r := New(is, p)
+		if err := r.ReplaceAll(nodes, tmpl); err != nil {
+			t.Errorf("ReplaceAll(%q, %q) err = %s, want nil", test.path, test.template, err)
+			continue
+		}
+
+		if got := r.Text(); got != test.want {
+			t.Errorf("ReplaceAll(%q, %q) = %q, want %q", test.path, test.template, got, test.want)
+		}
+	}
+}
+
+func TestReplaceOverlap(t *testing.T) {
+	is := antlr.NewInputStream(`{"a": {"b": 1}}`)
+	p := json.NewJSONParser(antlr.NewCommonTokenStream(json.NewJSONLexer(is), antlr.TokenDefaultChannel))
+	tree := p.Json()
+
+	objs := xpath.MustCompile("//obj", p).Evaluate(tree)
+	if len(objs) != 2 {
+		t.Fatalf("//obj found %d nodes, want 2", len(objs))
+	}
+
+	r := New(is, p)
+	if err := r.Replace(objs[0], "{}"); err != nil {
+		t.Errorf("Replace(outer) err = %s, want nil", err)
+	}
+	if err := r.Replace(objs[1], "{}"); err == nil {
+		t.Errorf("Replace(inner) err = nil, want overlap error")
+	}
+}
+
+func TestReplaceConjured(t *testing.T) {
+	const input = `{"a" 1}`
+	is := antlr.NewInputStream(input)
+	p := json.NewJSONParser(antlr.NewCommonTokenStream(json.NewJSONLexer(is), antlr.TokenDefaultChannel))
+	p.RemoveErrorListeners()
+	p.Json()
+
+	// A token conjured up by error recovery, such as the missing ':'.
+	conjured := antlr.NewErrorNodeImpl(antlr.NewCommonToken(&antlr.TokenSourceCharStreamPair{}, antlr.TokenInvalidType, antlr.TokenDefaultChannel, -1, -1))
+
+	r := New(is, p)
+	if err := r.Replace(conjured, ":"); err == nil {
+		t.Errorf("Replace(conjured) err = nil, want error")
+	}
+
+	tmpl, err := NewTemplate(":")
+	if err != nil {
+		t.Fatalf("NewTemplate(...) err = %s, want nil", err)
+	}
+	if err := r.ReplaceAll([]antlr.ParseTree{conjured}, tmpl); err != nil {
+		t.Errorf("ReplaceAll(conjured) err = %s, want nil", err)
+	}
+	if got := r.Text(); got != input {
+		t.Errorf("ReplaceAll(conjured) = %q, want it skipped: %q", got, input)
+	}
+}
+
+func TestTextInsertOrder(t *testing.T) {
+	is := antlr.NewInputStream(`ab`)
+	r := New(is, nil)
+
+	// Insertions at the same offset are kept in the order they were added.
+	for _, text := range []string{"1", "2", "3", "4", "5", "6", "7", "8", "9"} {
+		r.edits = append(r.edits, edit{start: 1, stop: 0, text: text})
+	}
+	if got, want := r.Text(), "a123456789b"; got != want {
+		t.Errorf("Text() = %q, want %q", got, want)
+	}
+}
+
+func TestReplaceInsertAtReplacement(t *testing.T) {
+	// An empty rule at the start of the node "ab".
+	empty := antlr.NewBaseParserRuleContext(nil, -1)
+	start := antlr.NewCommonToken(&antlr.TokenSourceCharStreamPair{}, antlr.TokenInvalidType, antlr.TokenDefaultChannel, 0, 0)
+	start.SetTokenIndex(1)
+	empty.SetStart(start)
+
+	token := antlr.NewCommonToken(&antlr.TokenSourceCharStreamPair{}, antlr.TokenInvalidType, antlr.TokenDefaultChannel, 0, 1)
+	token.SetTokenIndex(0)
+	node := antlr.NewTerminalNodeImpl(token)
+
+	tests := []struct {
+		name   string
+		first  antlr.ParseTree
+		second antlr.ParseTree
+	}{
+		{"replace then insert", node, empty},
+		{"insert then replace", empty, node},
+	}
+
+	for _, test := range tests {
+		r := New(antlr.NewInputStream(`abc`), nil)
+		if err := r.Replace(test.first, "1"); err != nil {
+			t.Errorf("%s: Replace(first) err = %s, want nil", test.name, err)
+		}
+		if err := r.Replace(test.second, "2"); err == nil {
+			t.Errorf("%s: Replace(second) err = nil, want overlap error", test.name)
+		}
+		// Text must not panic.
+		r.Text()
+	}
+}
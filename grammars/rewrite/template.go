@@ -0,0 +1,101 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rewrite
+
+import (
+	"strings"
+	"text/template"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// Funcs are the extra functions available to replacement templates.
+var Funcs = template.FuncMap{
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"trim":  strings.TrimSpace,
+	"replace": func(old, new, s string) string {
+		return strings.Replace(s, old, new, -1)
+	},
+}
+
+// NewTemplate parses a replacement template, for use with ReplaceAll. For
+// example, to uppercase every matched node:
+//
+//	{{ .Text | upper }}
+//
+// or to swap the two sides of a JSON pair:
+//
+//	{{ .Child "value" }}: {{ .Child "STRING" }}
+func NewTemplate(text string) (*template.Template, error) {
+	return template.New("replace").Funcs(Funcs).Parse(text)
+}
+
+// Match is the data given to a replacement template.
+type Match struct {
+	Node antlr.ParseTree
+
+	r *Rewriter
+}
+
+// Text returns the original source text of the matched node.
+func (m *Match) Text() string {
+	return m.r.source(m.Node)
+}
+
+// Child returns the original source text of the first child with the given
+// rule or token name, or the empty string if there is no such child.
+func (m *Match) Child(name string) string {
+	for _, c := range m.Node.GetChildren() {
+		if m.r.hasName(c, name) {
+			return m.r.source(c.(antlr.ParseTree))
+		}
+	}
+	return ""
+}
+
+// Children returns the original source text of every child with the given
+// rule or token name.
+func (m *Match) Children(name string) []string {
+	var children []string
+	for _, c := range m.Node.GetChildren() {
+		if m.r.hasName(c, name) {
+			children = append(children, m.r.source(c.(antlr.ParseTree)))
+		}
+	}
+	return children
+}
+
+// hasName returns true if the node is a rule, or token with the given name.
+// Tokens may be named by their symbolic or literal name, e.g ID or 'return'.
+func (r *Rewriter) hasName(t antlr.Tree, name string) bool {
+	switch n := t.(type) {
+	case antlr.RuleContext:
+		return nameAt(r.recognizer.GetRuleNames(), n.GetRuleIndex()) == name
+
+	case antlr.TerminalNode:
+		ttype := n.GetSymbol().GetTokenType()
+		return nameAt(r.recognizer.GetSymbolicNames(), ttype) == name ||
+			nameAt(r.recognizer.GetLiteralNames(), ttype) == name
+	}
+	return false
+}
+
+func nameAt(names []string, i int) string {
+	if i < 0 || i >= len(names) {
+		return ""
+	}
+	return names[i]
+}
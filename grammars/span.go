@@ -0,0 +1,132 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grammars
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// Span is a range of the input, from the index of the first code point, to
+// the index of the last (inclusive). Stop is Start - 1 for an empty span.
+type Span struct {
+	Start, Stop int
+}
+
+// Trivia selects which of the hidden tokens (such as whitespace and
+// comments) around a node are included in its Span.
+type Trivia int
+
+const (
+	// LeadingTrivia is the hidden tokens before the node, on the lines after
+	// the previous visible token.
+	LeadingTrivia Trivia = 1 << iota
+
+	// TrailingTrivia is the hidden tokens after the node, up to and including
+	// the end of the node's last line.
+	TrailingTrivia
+
+	NoTrivia  Trivia = 0
+	AllTrivia        = LeadingTrivia | TrailingTrivia
+)
+
+// Span returns the span of the node's source text, extended to include the
+// trivia. Trivia is split between neighbouring nodes, so a trailing comment
+// belongs to the node on the same line, and is not also leading trivia of the
+// node that follows. This allows formatters and refactoring tools to cut and
+// paste regions of code faithfully.
+func (r *Result) Span(node antlr.ParseTree, trivia Trivia) (Span, error) {
+	var first, last antlr.Token
+	switch n := node.(type) {
+	case antlr.ParserRuleContext:
+		first, last = n.GetStart(), n.GetStop()
+		if first == nil {
+			return Span{}, fmt.Errorf("node %T has no start token", node)
+		}
+		if last == nil || last.GetTokenIndex() < first.GetTokenIndex() {
+			// A empty rule, has no text or trivia.
+			return Span{first.GetStart(), first.GetStart() - 1}, nil
+		}
+	case antlr.TerminalNode:
+		first, last = n.GetSymbol(), n.GetSymbol()
+	default:
+		return Span{}, fmt.Errorf("unsupported node %T", node)
+	}
+	if first.GetStart() < 0 || first.GetTokenIndex() < 0 {
+		// Such as a token conjured up by the parser's error recovery.
+		return Span{}, fmt.Errorf("node %T is not in the input", node)
+	}
+
+	r.Tokens.Fill()
+	tokens := r.Tokens.GetAllTokens()
+	span := Span{first.GetStart(), last.GetStop()}
+
+	if trivia&LeadingTrivia != 0 {
+		i := first.GetTokenIndex() - 1
+		for i >= 0 && isHidden(tokens[i]) {
+			i--
+		}
+		// The hidden tokens on the previous visible token's last line are
+		// its trailing trivia.
+		previous := 0
+		if i >= 0 {
+			previous = endLine(tokens[i])
+		}
+		for i++; i < first.GetTokenIndex(); i++ {
+			if tokens[i].GetLine() > previous {
+				span.Start = tokens[i].GetStart()
+				break
+			}
+		}
+	}
+
+	if trivia&TrailingTrivia != 0 {
+		line := endLine(last)
+		for i := last.GetTokenIndex() + 1; i < len(tokens) && isHidden(tokens[i]); i++ {
+			if tokens[i].GetLine() != line {
+				break
+			}
+			span.Stop = tokens[i].GetStop()
+			if endLine(tokens[i]) != line {
+				break
+			}
+		}
+	}
+
+	return span, nil
+}
+
+// Text returns the source text of the node, extended to include the trivia.
+func (r *Result) Text(node antlr.ParseTree, trivia Trivia) (string, error) {
+	span, err := r.Span(node, trivia)
+	if err != nil {
+		return "", err
+	}
+	if span.Stop < span.Start {
+		return "", nil
+	}
+	return r.Tokens.GetTokenSource().GetInputStream().GetText(span.Start, span.Stop), nil
+}
+
+func isHidden(t antlr.Token) bool {
+	return t.GetTokenType() != antlr.TokenEOF && t.GetChannel() != antlr.TokenDefaultChannel
+}
+
+// endLine returns the line the token ends on.
+func endLine(t antlr.Token) int {
+	return t.GetLine() + strings.Count(t.GetText(), "\n")
+}
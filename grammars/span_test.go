@@ -0,0 +1,76 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grammars_test
+
+import (
+	"testing"
+
+	_ "bramp.net/antlr4/ecmascript"
+	"bramp.net/antlr4/grammars"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// findRules returns every node of the tree which is the named rule.
+func findRules(tree antlr.Tree, parser antlr.Parser, rule string) []antlr.ParserRuleContext {
+	var found []antlr.ParserRuleContext
+	if ctx, ok := tree.(antlr.ParserRuleContext); ok && parser.GetRuleNames()[ctx.GetRuleIndex()] == rule {
+		found = append(found, ctx)
+	}
+	for _, child := range tree.GetChildren() {
+		found = append(found, findRules(child, parser, rule)...)
+	}
+	return found
+}
+
+func TestText(t *testing.T) {
+	const input = "// leading\nvar a = 1; // trailing\nvar b = 2;\n"
+
+	g := grammars.Lookup("ecmascript")
+	result, err := g.Parse(antlr.NewInputStream(input))
+	if err != nil || len(result.Errors) > 0 {
+		t.Fatalf("Parse(%q) = %v, %s", input, result.Errors, err)
+	}
+
+	statements := findRules(result.Tree, result.Parser, "variableStatement")
+	if len(statements) != 2 {
+		t.Fatalf("Parse(%q) found %d variableStatements, want 2", input, len(statements))
+	}
+
+	tests := []struct {
+		node   int
+		trivia grammars.Trivia
+		want   string
+	}{
+		{0, grammars.NoTrivia, "var a = 1;"},
+		{0, grammars.LeadingTrivia, "// leading\nvar a = 1;"},
+		{0, grammars.TrailingTrivia, "var a = 1; // trailing\n"},
+		{0, grammars.AllTrivia, "// leading\nvar a = 1; // trailing\n"},
+
+		// The newline before b is the trailing trivia of a.
+		{1, grammars.LeadingTrivia, "var b = 2;"},
+		{1, grammars.AllTrivia, "var b = 2;\n"},
+	}
+
+	for _, test := range tests {
+		got, err := result.Text(statements[test.node], test.trivia)
+		if err != nil {
+			t.Errorf("Text(statement %d, %d) err = %s", test.node, test.trivia, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("Text(statement %d, %d) = %q, want %q", test.node, test.trivia, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grammars
+
+import (
+	"reflect"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// Stats describes the size of a grammar.
+type Stats struct {
+	LexerRules  int // Number of lexer rules, including fragments
+	ParserRules int // Number of parser rules
+	Tokens      int // Number of token types
+	LexerModes  int
+
+	// Number of states and decisions in the lexer and parser ATNs. These
+	// roughly reflect the memory used, and work done by the recognizers.
+	LexerStates     int
+	LexerDecisions  int
+	ParserStates    int
+	ParserDecisions int
+}
+
+// Stats returns the size of the grammar, by inspecting a new Lexer and Parser.
+func (g *Grammar) Stats() *Stats {
+	lexer := g.NewLexer(antlr.NewInputStream(""))
+	latn := lexer.GetATN()
+
+	s := &Stats{
+		LexerRules:     len(lexer.GetRuleNames()),
+		Tokens:         numTokens(lexer),
+		LexerModes:     unexportedLen(latn, "modeToStartState"),
+		LexerStates:    unexportedLen(latn, "states"),
+		LexerDecisions: len(latn.DecisionToState),
+	}
+
+	if g.HasParser() {
+		parser := g.NewParser(antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel))
+		patn := parser.GetATN()
+
+		s.ParserRules = len(parser.GetRuleNames())
+		s.ParserStates = unexportedLen(patn, "states")
+		s.ParserDecisions = len(patn.DecisionToState)
+	}
+
+	return s
+}
+
+// numTokens returns the number of token types the recognizer defines.
+func numTokens(recognizer antlr.Recognizer) int {
+	n := len(recognizer.GetSymbolicNames())
+	if l := len(recognizer.GetLiteralNames()); l > n {
+		n = l
+	}
+	// Type 0 is never used (as antlr.TokenInvalidType).
+	if n > 0 {
+		n--
+	}
+	return n
+}
+
+// unexportedLen returns the length of one of the ATN's slices. The runtime
+// doesn't export them, so this peeks at the unexported field.
+func unexportedLen(atn *antlr.ATN, field string) int {
+	if atn == nil {
+		return 0
+	}
+	v := reflect.ValueOf(atn).Elem().FieldByName(field)
+	if !v.IsValid() || v.Kind() != reflect.Slice {
+		return 0
+	}
+	return v.Len()
+}
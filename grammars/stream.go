@@ -0,0 +1,79 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grammars
+
+import (
+	"io/ioutil"
+
+	"bramp.net/antlr4/internal"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// NamedStream is a CharStream which records the file it was read from, and
+// an optional logical ID, such as a URI or database key. When parsing many
+// files it allows tokens, and the syntax errors found by Parse and Tokenize,
+// to be traced back to their file.
+type NamedStream struct {
+	antlr.CharStream
+
+	Filename string
+	ID       string
+}
+
+// NewNamedStream wraps the input with the filename and ID.
+func NewNamedStream(input antlr.CharStream, filename, id string) *NamedStream {
+	return &NamedStream{
+		CharStream: input,
+		Filename:   filename,
+		ID:         id,
+	}
+}
+
+// NewNamedFileStream reads the named file into a NamedStream. The file is
+// transcoded to UTF-8 if DetectCharset finds it is in another encoding.
+func NewNamedFileStream(filename string) (*NamedStream, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	text, _ := DecodeCharset(data)
+	return NewNamedStream(antlr.NewInputStream(text), filename, ""), nil
+}
+
+// GetSourceName returns the Filename.
+func (s *NamedStream) GetSourceName() string {
+	return s.Filename
+}
+
+// StreamOf returns the NamedStream the token was read from, or nil if it
+// was not read from one.
+func StreamOf(t antlr.Token) *NamedStream {
+	return namedStream(t.GetInputStream())
+}
+
+// namedStream returns the NamedStream wrapped by input, looking through the
+// CaseChangingStream added by NewCharStream.
+func namedStream(input antlr.CharStream) *NamedStream {
+	for {
+		switch s := input.(type) {
+		case *NamedStream:
+			return s
+		case *internal.CaseChangingStream:
+			input = s.CharStream
+		default:
+			return nil
+		}
+	}
+}
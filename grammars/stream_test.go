@@ -0,0 +1,66 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grammars_test
+
+import (
+	"testing"
+
+	"bramp.net/antlr4/grammars"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+func TestNamedStream(t *testing.T) {
+	g := grammars.Lookup("json")
+	input := grammars.NewNamedStream(antlr.NewInputStream(`{"a": }`), "a.json", "id-1")
+
+	result, err := g.Parse(input)
+	if err != nil {
+		t.Fatalf("Parse(...) = %s", err)
+	}
+
+	if len(result.Errors) == 0 {
+		t.Fatalf("Parse(...) found no errors, want at least one")
+	}
+	if got, want := result.Errors[0].Filename, "a.json"; got != want {
+		t.Errorf("Parse(...).Errors[0].Filename = %q, want %q", got, want)
+	}
+
+	for _, tok := range result.Tokens.GetAllTokens() {
+		s := grammars.StreamOf(tok)
+		if s == nil || s.Filename != "a.json" || s.ID != "id-1" {
+			t.Errorf("StreamOf(%q) = %v, want the a.json stream", tok.GetText(), s)
+		}
+	}
+
+	tok := antlr.NewCommonToken(&antlr.TokenSourceCharStreamPair{}, 1, antlr.TokenDefaultChannel, 0, 0)
+	if got := grammars.StreamOf(tok); got != nil {
+		t.Errorf("StreamOf(unnamed) = %v, want nil", got)
+	}
+}
+
+func TestSyntaxErrorString(t *testing.T) {
+	tests := []struct {
+		err  *grammars.SyntaxError
+		want string
+	}{
+		{&grammars.SyntaxError{Line: 1, Column: 2, Msg: "bad"}, "1:2: bad"},
+		{&grammars.SyntaxError{Filename: "a.json", Line: 1, Column: 2, Msg: "bad"}, "a.json:1:2: bad"},
+	}
+	for _, test := range tests {
+		if got := test.err.Error(); got != test.want {
+			t.Errorf("Error() = %q, want %q", got, test.want)
+		}
+	}
+}
@@ -0,0 +1,225 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package symbols extracts the identifiers declared and used in a parse tree,
+// as a building block for cross-language indexing and renaming tools.
+//
+// Grammars name their identifiers, and the rules that declare them,
+// differently, so each family of grammars is described by a Config listing
+// those names. Configs holds the configs for the supported grammars:
+//
+//	e, err := symbols.New(symbols.Configs["c"], result.Parser)
+//	for _, s := range e.Extract(result.Tree) {
+//		fmt.Println(s.Kind, s.Name)
+//	}
+//
+// An identifier is a declaration if the rule directly containing it is one
+// of the config's Declarations, otherwise it is a use. This is a heuristic,
+// so, for example, "struct s" in C is reported as a declaration of s, even
+// when it only refers to an existing struct.
+package symbols // import "bramp.net/antlr4/grammars/symbols"
+
+import (
+	"fmt"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// Config describes how a family of grammars declares identifiers.
+type Config struct {
+	// Tokens are the names of the identifier tokens, e.g "Identifier".
+	Tokens []string
+
+	// Rules are the names of rules that match a single identifier, e.g
+	// "identifier", for grammars that wrap their identifier tokens.
+	Rules []string
+
+	// Declarations are the names of rules whose identifiers are declared by
+	// that rule, e.g "functionDefinition".
+	Declarations []string
+}
+
+// Configs contains the Config for each supported grammar, by name.
+var Configs = map[string]*Config{
+	"c": {
+		Tokens: []string{"Identifier"},
+		Declarations: []string{
+			"directDeclarator", "enumerationConstant", "enumSpecifier",
+			"identifierList", "labeledStatement", "structOrUnionSpecifier",
+		},
+	},
+	"ecmascript": {
+		Tokens: []string{"Identifier"},
+		Declarations: []string{
+			"formalParameterList", "functionDeclaration", "labelledStatement",
+			"variableDeclaration",
+		},
+	},
+	"pl0": {
+		Rules:        []string{"ident"},
+		Declarations: []string{"consts", "procedure", "vars"},
+	},
+	"solidity": {
+		Rules: []string{"identifier"},
+		Declarations: []string{
+			"contractDefinition", "enumDefinition", "enumValue",
+			"eventDefinition", "eventParameter", "functionDefinition",
+			"modifierDefinition", "parameter", "stateVariableDeclaration",
+			"structDefinition", "variableDeclaration",
+		},
+	},
+}
+
+// Kind is the kind of Symbol.
+type Kind int
+
+const (
+	Use Kind = iota
+	Declaration
+)
+
+func (k Kind) String() string {
+	if k == Declaration {
+		return "declaration"
+	}
+	return "use"
+}
+
+// Symbol is a identifier found in the tree.
+type Symbol struct {
+	Name string
+	Kind Kind
+
+	// Node is the identifier's token, or rule if the config's Rules matched.
+	Node antlr.ParseTree
+
+	// Rule is the name of the rule directly containing the identifier.
+	Rule string
+}
+
+// Pos returns the line (starting at 1) and column (starting at 0) where the
+// symbol starts.
+func (s *Symbol) Pos() (line, column int) {
+	var tok antlr.Token
+	switch n := s.Node.(type) {
+	case antlr.TerminalNode:
+		tok = n.GetSymbol()
+	case antlr.ParserRuleContext:
+		tok = n.GetStart()
+	}
+	if tok == nil {
+		return 0, 0
+	}
+	return tok.GetLine(), tok.GetColumn()
+}
+
+// Extractor extracts the symbols from trees built by one grammar.
+type Extractor struct {
+	ruleNames    []string
+	tokens       map[int]bool // Token types
+	rules        map[int]bool // Rule indexes
+	declarations map[int]bool // Rule indexes
+}
+
+// New returns a Extractor for the config, looking up the rule and token
+// names with the recognizer (normally the Parser that built the tree).
+func New(config *Config, recognizer antlr.Recognizer) (*Extractor, error) {
+	if config == nil {
+		return nil, fmt.Errorf("symbols: nil config")
+	}
+	if len(config.Tokens) == 0 && len(config.Rules) == 0 {
+		return nil, fmt.Errorf("symbols: config has no identifier tokens or rules")
+	}
+
+	e := &Extractor{ruleNames: recognizer.GetRuleNames()}
+
+	var err error
+	if e.tokens, err = lookup(recognizer.GetSymbolicNames(), config.Tokens, "token"); err != nil {
+		return nil, err
+	}
+	if e.rules, err = lookup(e.ruleNames, config.Rules, "rule"); err != nil {
+		return nil, err
+	}
+	if e.declarations, err = lookup(e.ruleNames, config.Declarations, "rule"); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// lookup returns the indexes of the wanted names.
+func lookup(names, want []string, kind string) (map[int]bool, error) {
+	found := make(map[int]bool)
+	for _, w := range want {
+		i := indexOf(names, w)
+		if i < 0 {
+			return nil, fmt.Errorf("symbols: %s isn't a valid %s name", w, kind)
+		}
+		found[i] = true
+	}
+	return found, nil
+}
+
+func indexOf(names []string, name string) int {
+	for i, n := range names {
+		if n == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// Extract returns the symbols in the tree, in the order they appear.
+func (e *Extractor) Extract(t antlr.Tree) []*Symbol {
+	var symbols []*Symbol
+	var walk func(t antlr.Tree, parent int)
+	walk = func(t antlr.Tree, parent int) {
+		switch n := t.(type) {
+		case antlr.ErrorNode:
+			return
+
+		case antlr.TerminalNode:
+			if e.tokens[n.GetSymbol().GetTokenType()] {
+				symbols = append(symbols, e.symbol(n, n.GetText(), parent))
+			}
+			return
+
+		case antlr.RuleContext:
+			if e.rules[n.GetRuleIndex()] {
+				symbols = append(symbols, e.symbol(n.(antlr.ParseTree), n.GetText(), parent))
+				return
+			}
+			parent = n.GetRuleIndex()
+		}
+
+		for _, c := range t.GetChildren() {
+			walk(c, parent)
+		}
+	}
+	walk(t, -1)
+	return symbols
+}
+
+func (e *Extractor) symbol(node antlr.ParseTree, name string, parent int) *Symbol {
+	s := &Symbol{
+		Name: name,
+		Node: node,
+	}
+	if e.declarations[parent] {
+		s.Kind = Declaration
+	}
+	if parent >= 0 && parent < len(e.ruleNames) {
+		s.Rule = e.ruleNames[parent]
+	}
+	return s
+}
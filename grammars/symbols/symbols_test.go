@@ -0,0 +1,97 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package symbols_test
+
+import (
+	"fmt"
+	"testing"
+
+	"bramp.net/antlr4/grammars"
+	"bramp.net/antlr4/grammars/symbols"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/kylelemons/godebug/pretty"
+
+	_ "bramp.net/antlr4/c"
+	_ "bramp.net/antlr4/ecmascript"
+	_ "bramp.net/antlr4/pl0"
+	_ "bramp.net/antlr4/solidity"
+)
+
+func newParser(g *grammars.Grammar, input string) antlr.Parser {
+	lexer := g.NewLexer(antlr.NewInputStream(input))
+	return g.NewParser(antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel))
+}
+
+// TestConfigs checks every config refers to names in its grammar.
+func TestConfigs(t *testing.T) {
+	for name, config := range symbols.Configs {
+		g := grammars.Lookup(name)
+		if g == nil {
+			t.Errorf("Configs[%q] has no matching grammar", name)
+			continue
+		}
+		if _, err := symbols.New(config, newParser(g, "")); err != nil {
+			t.Errorf("New(Configs[%q]) err = %s, want nil", name, err)
+		}
+	}
+}
+
+func TestExtract(t *testing.T) {
+	const input = "VAR x, y;\nPROCEDURE p;\nBEGIN x := y END;\nCALL p.\n"
+
+	result, err := grammars.Lookup("pl0").Parse(antlr.NewInputStream(input))
+	if err != nil {
+		t.Fatalf("Parse(%q) err = %s, want nil", input, err)
+	}
+
+	e, err := symbols.New(symbols.Configs["pl0"], result.Parser)
+	if err != nil {
+		t.Fatalf("New(Configs[%q]) err = %s, want nil", "pl0", err)
+	}
+
+	var got []string
+	for _, s := range e.Extract(result.Tree) {
+		line, column := s.Pos()
+		got = append(got, fmt.Sprintf("%d:%d %s %s in %s", line, column, s.Kind, s.Name, s.Rule))
+	}
+
+	want := []string{
+		"1:4 declaration x in vars",
+		"1:7 declaration y in vars",
+		"2:10 declaration p in procedure",
+		"3:6 use x in assignstmt",
+		"3:11 use y in factor",
+		"4:5 use p in callstmt",
+	}
+	if diff := pretty.Compare(got, want); diff != "" {
+		t.Errorf("Extract(%q) diff: (-got +want)\n%s", input, diff)
+	}
+}
+
+func TestNewErrors(t *testing.T) {
+	p := newParser(grammars.Lookup("pl0"), "")
+	tests := []*symbols.Config{
+		nil,
+		{},
+		{Tokens: []string{"NOPE"}},
+		{Rules: []string{"nope"}},
+		{Rules: []string{"ident"}, Declarations: []string{"nope"}},
+	}
+	for _, config := range tests {
+		if _, err := symbols.New(config, p); err == nil {
+			t.Errorf("New(%+v) err = nil, want error", config)
+		}
+	}
+}
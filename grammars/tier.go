@@ -0,0 +1,68 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grammars
+
+import "fmt"
+
+// Tier is how well tested a grammar is, recorded from its test results when
+// it was generated.
+type Tier int
+
+const (
+	Untested Tier = iota // Compiles, but there were no examples to test it with
+	Flaky                // Its tests failed, but then passed when retried
+	Stable               // Passed its tests with all the examples from grammars-v4
+)
+
+var tierNames = []string{
+	Untested: "untested",
+	Flaky:    "flaky",
+	Stable:   "stable",
+}
+
+func (t Tier) String() string {
+	if t < 0 || int(t) >= len(tierNames) {
+		return "unknown"
+	}
+	return tierNames[t]
+}
+
+// MarshalText implements encoding.TextMarshaler, so a Tier is its name in JSON.
+func (t Tier) MarshalText() ([]byte, error) {
+	return []byte(t.String()), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler.
+func (t *Tier) UnmarshalText(text []byte) error {
+	for i, name := range tierNames {
+		if name == string(text) {
+			*t = Tier(i)
+			return nil
+		}
+	}
+	return fmt.Errorf("unknown tier %q", text)
+}
+
+// FilterTier returns the grammars of at least the min tier, e.g
+// FilterTier(All(), Stable) returns only the stable grammars.
+func FilterTier(gs []*Grammar, min Tier) []*Grammar {
+	var filtered []*Grammar
+	for _, g := range gs {
+		if g.Tier >= min {
+			filtered = append(filtered, g)
+		}
+	}
+	return filtered
+}
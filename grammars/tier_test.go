@@ -0,0 +1,71 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grammars_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"bramp.net/antlr4/grammars"
+)
+
+func TestTier(t *testing.T) {
+	if got, want := grammars.Lookup("json").Tier, grammars.Stable; got != want {
+		t.Errorf("Lookup(%q).Tier = %s, want %s", "json", got, want)
+	}
+
+	for _, tier := range []grammars.Tier{grammars.Untested, grammars.Flaky, grammars.Stable} {
+		data, err := json.Marshal(tier)
+		if err != nil {
+			t.Errorf("json.Marshal(%s) err = %s, want nil", tier, err)
+			continue
+		}
+
+		var got grammars.Tier
+		if err := json.Unmarshal(data, &got); err != nil || got != tier {
+			t.Errorf("json.Unmarshal(%s) = %s, %v, want %s, nil", data, got, err, tier)
+		}
+	}
+}
+
+func TestFilterTier(t *testing.T) {
+	stable := &grammars.Grammar{Name: "stable", Tier: grammars.Stable}
+	flaky := &grammars.Grammar{Name: "flaky", Tier: grammars.Flaky}
+	untested := &grammars.Grammar{Name: "untested", Tier: grammars.Untested}
+	all := []*grammars.Grammar{stable, flaky, untested}
+
+	tests := []struct {
+		min  grammars.Tier
+		want []*grammars.Grammar
+	}{
+		{grammars.Untested, all},
+		{grammars.Flaky, []*grammars.Grammar{stable, flaky}},
+		{grammars.Stable, []*grammars.Grammar{stable}},
+	}
+
+	for _, test := range tests {
+		got := grammars.FilterTier(all, test.min)
+		if len(got) != len(test.want) {
+			t.Errorf("FilterTier(..., %s) = %v, want %v", test.min, got, test.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != test.want[i] {
+				t.Errorf("FilterTier(..., %s) = %v, want %v", test.min, got, test.want)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,54 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tokenclass
+
+// overrides corrects the guessed class of tokens, keyed by grammar name, then
+// by symbolic token name.
+var overrides = map[string]map[string]Class{
+	"c": {
+		"Constant":         Number,
+		"DigitSequence":    Number,
+		"ComplexDefine":    Comment, // Preprocessor directives are skipped as if comments
+		"IncludeDirective": Comment,
+		"LineDirective":    Comment,
+		"PragmaDirective":  Comment,
+		"AsmBlock":         Other,
+	},
+	"cobol85": {
+		"BINARY":            Keyword,
+		"CHARACTER":         Keyword,
+		"DOUBLE":            Keyword,
+		"INTEGER":           Keyword,
+		"NUMBER":            Keyword,
+		"NUMERIC":           Keyword,
+		"REAL":              Keyword,
+		"STRING":            Keyword,
+		"INTEGERLITERAL":    Number,
+		"NUMERICLITERAL":    Number,
+		"NONNUMERICLITERAL": String,
+	},
+	"ecmascript": {
+		"BooleanLiteral":           Keyword,
+		"RegularExpressionLiteral": String,
+		"UnexpectedCharacter":      Other,
+	},
+	"solidity": {
+		"BooleanLiteral": Keyword,
+		"Int":            Keyword,
+		"Uint":           Keyword,
+		"NumberUnit":     Keyword,
+		"VersionLiteral": Number,
+	},
+}
@@ -0,0 +1,115 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tokenclass
+
+import (
+	"strings"
+)
+
+// Scopes of keywords with a more specific meaning, keyed by the lower case
+// keyword.
+var keywordScopes = map[string]string{}
+
+func init() {
+	for scope, ks := range map[string][]string{
+		"keyword.control": {
+			"if", "then", "else", "elif", "elsif", "elseif", "endif", "end_if", "while", "wend", "for", "foreach",
+			"do", "loop", "until", "repeat", "break", "continue", "return", "goto", "switch", "case", "default",
+			"when", "try", "catch", "finally", "throw", "raise", "except", "yield", "exit",
+		},
+		"keyword.other.import": {"import", "include", "package", "using", "require", "from"},
+		"constant.language":    {"true", "false", "null", "nil", "none", "undefined", "this", "self", "super"},
+		"storage.type": {
+			"int", "integer", "long", "short", "byte", "char", "float", "double", "real", "decimal", "bool",
+			"boolean", "string", "void", "var", "let", "const", "class", "struct", "enum", "interface",
+			"function", "func", "def", "procedure", "type", "typedef",
+		},
+		"storage.modifier": {
+			"public", "private", "protected", "static", "final", "abstract", "extern", "volatile", "register",
+			"inline", "override", "virtual", "readonly",
+		},
+	} {
+		for _, k := range ks {
+			keywordScopes[k] = scope
+		}
+	}
+}
+
+// Scope returns a TextMate style scope for the token type, such as
+// "keyword.control.json", so editors can highlight tokens with their existing
+// themes. The scope is empty for whitespace, as it is not highlighted.
+func (c *Classifier) Scope(tokenType int) string {
+	scope := c.scope(tokenType)
+	if scope == "" || c.lang == "" {
+		return scope
+	}
+	return scope + "." + c.lang
+}
+
+func (c *Classifier) scope(tokenType int) string {
+	name, literal := "", ""
+	if tokenType >= 0 && tokenType < len(c.symbolic) {
+		name = strings.ToUpper(c.symbolic[tokenType])
+	}
+	if tokenType >= 0 && tokenType < len(c.literal) {
+		literal = strings.Trim(c.literal[tokenType], "'")
+	}
+
+	switch c.Class(tokenType) {
+	case Keyword:
+		word := literal
+		if word == "" {
+			word = name
+		}
+		if scope, found := keywordScopes[strings.ToLower(word)]; found {
+			return scope
+		}
+		return "keyword.other"
+
+	case Identifier:
+		return "variable.other"
+
+	case String:
+		return "string.quoted"
+
+	case Number:
+		return "constant.numeric"
+
+	case Comment:
+		// Check for block comments first, as MULTI_LINE_COMMENT also
+		// contains LINE.
+		switch {
+		case strings.Contains(name, "BLOCK") || strings.Contains(name, "MULTI") ||
+			strings.HasPrefix(name, "ML") || strings.Contains(name, "DELIMITED"):
+			return "comment.block"
+		case strings.Contains(name, "LINE") || strings.HasPrefix(name, "SL") || strings.HasPrefix(name, "SINGLE"):
+			return "comment.line"
+		}
+		return "comment"
+
+	case Operator:
+		switch literal {
+		case "(", ")", "[", "]", "{", "}":
+			return "punctuation.section"
+		case ",", ";", ":", ".":
+			return "punctuation.separator"
+		}
+		return "keyword.operator"
+
+	case Whitespace:
+		return ""
+	}
+	return "source"
+}
@@ -0,0 +1,234 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tokenclass maps each grammar's token types to a small set of
+// generic classes, such as Keyword or String, so tokens from any grammar can
+// be highlighted or measured in the same way.
+//
+// The classes are guessed from the token's literal and symbolic names, for
+// example 'return' is a Keyword, and StringLiteral is a String. Guesses that
+// are known to be wrong are corrected by per-grammar overrides.
+package tokenclass // import "bramp.net/antlr4/grammars/tokenclass"
+
+import (
+	"strings"
+
+	"bramp.net/antlr4/grammars"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/iancoleman/strcase"
+)
+
+// Class is a generic class of token.
+type Class int
+
+const (
+	Other Class = iota
+	Keyword
+	Identifier
+	String
+	Number
+	Comment
+	Operator
+	Whitespace
+)
+
+var classNames = []string{
+	Other:      "other",
+	Keyword:    "keyword",
+	Identifier: "identifier",
+	String:     "string",
+	Number:     "number",
+	Comment:    "comment",
+	Operator:   "operator",
+	Whitespace: "whitespace",
+}
+
+func (c Class) String() string {
+	if c < 0 || int(c) >= len(classNames) {
+		return "unknown"
+	}
+	return classNames[c]
+}
+
+// Classifier classifies the tokens of one grammar.
+type Classifier struct {
+	lang     string  // Grammar name, used as the suffix of scopes
+	classes  []Class // Indexed by token type
+	symbolic []string
+	literal  []string
+}
+
+// New returns a Classifier for the grammar.
+func New(g *grammars.Grammar) *Classifier {
+	c := NewFromRecognizer(g.NewLexer(antlr.NewInputStream("")), overrides[g.Name])
+	c.lang = g.Name
+	return c
+}
+
+// NewFromRecognizer returns a Classifier using the recognizer's token names,
+// and the overrides (keyed by symbolic name).
+func NewFromRecognizer(recognizer antlr.Recognizer, overrides map[string]Class) *Classifier {
+	symbolic := recognizer.GetSymbolicNames()
+	literal := recognizer.GetLiteralNames()
+
+	n := len(symbolic)
+	if len(literal) > n {
+		n = len(literal)
+	}
+
+	c := &Classifier{
+		classes:  make([]Class, n),
+		symbolic: symbolic,
+		literal:  literal,
+	}
+	for i := range c.classes {
+		var s, l string
+		if i < len(symbolic) {
+			s = symbolic[i]
+		}
+		if i < len(literal) {
+			l = literal[i]
+		}
+
+		if class, found := overrides[s]; found && s != "" {
+			c.classes[i] = class
+		} else {
+			c.classes[i] = Guess(s, l)
+		}
+	}
+	return c
+}
+
+// Class returns the class of the token type.
+func (c *Classifier) Class(tokenType int) Class {
+	if tokenType < 0 || tokenType >= len(c.classes) {
+		return Other
+	}
+	return c.classes[tokenType]
+}
+
+// Filter returns the tokens of any of the given classes, e.g
+// Filter(tokens, Comment) returns just the comments, whichever channel the
+// grammar puts them on.
+func (c *Classifier) Filter(tokens []antlr.Token, classes ...Class) []antlr.Token {
+	var filtered []antlr.Token
+	for _, t := range tokens {
+		class := c.Class(t.GetTokenType())
+		for _, want := range classes {
+			if class == want {
+				filtered = append(filtered, t)
+				break
+			}
+		}
+	}
+	return filtered
+}
+
+// Words in symbolic names, that suggest the token's class.
+var (
+	commentWords    = words("COMMENT", "COMMENTS")
+	whitespaceWords = words("WS", "WHITESPACE", "SPACE", "SPACES", "SP", "NEWLINE", "NEWLINES", "NL", "EOL", "CRLF", "LF", "CR", "LINEFEED", "TAB", "TABS", "HTAB", "BLANK", "BLANKS")
+	stringWords     = words("STRING", "STRINGS", "STR", "CHAR", "CHARACTER", "QUOTED")
+	numberWords     = words("NUMBER", "NUMBERS", "NUM", "NUMERIC", "INT", "INTEGER", "FLOAT", "DECIMAL", "REAL", "DIGIT", "DIGITS", "HEX", "OCTAL", "BINARY", "DOUBLE")
+	identifierWords = words("ID", "IDENT", "IDENTIFIER", "NAME")
+	operatorWords   = words("PAREN", "PARENTHESIS", "BRACE", "BRACKET", "BRACK", "OPERATOR", "OP")
+
+	// operatorNames are the common names given to operator and punctuation
+	// tokens that don't have a literal name.
+	operatorNames = words(
+		"COMMA", "DOT", "MINUS", "PLUS", "COLON", "SEMI", "SEMICOLON", "EQ", "EQUAL", "EQUALS", "ASSIGN",
+		"LT", "GT", "LE", "GE", "NE", "NEQ", "NOTEQUAL", "STAR", "TIMES", "MUL", "DIV", "DIVIDE", "SLASH",
+		"BACKSLASH", "MOD", "PERCENT", "POW", "CARET", "AT", "HASH", "QUESTION", "BANG", "EXCLAMATION", "PIPE",
+		"BAR", "AMP", "AMPERSAND", "TILDE", "ARROW", "DOLLAR", "ADD", "SUB", "LP", "RP", "LB", "RB", "LCURLY",
+		"RCURLY", "LPAREN", "RPAREN", "LPAR", "RPAR", "LBRACE", "RBRACE", "LBRACK", "RBRACK", "LBRACKET",
+		"RBRACKET", "UNDERSCORE", "QUOTE", "DQUOTE", "SQUOTE", "APOSTROPHE", "ELLIPSIS")
+)
+
+func words(ws ...string) map[string]bool {
+	m := make(map[string]bool)
+	for _, w := range ws {
+		m[w] = true
+	}
+	return m
+}
+
+// Guess returns the likely class of a token, given its symbolic name (e.g
+// "StringLiteral") and literal name (e.g "'return'"). Either may be empty.
+func Guess(symbolic, literal string) Class {
+	if literal != "" {
+		l := strings.TrimSuffix(strings.TrimPrefix(literal, "'"), "'")
+		switch {
+		case isWord(l):
+			return Keyword
+		case l == " " || l == `\t` || l == `\n` || l == `\r` || l == `\r\n`:
+			return Whitespace
+		}
+		return Operator
+	}
+
+	if symbolic == "" {
+		return Other
+	}
+
+	name := strings.ToUpper(strcase.ToSnake(symbolic))
+	ws := strings.Split(name, "_")
+	switch {
+	case containsAny(ws, commentWords):
+		return Comment
+	case containsAny(ws, whitespaceWords):
+		return Whitespace
+	case containsAny(ws, stringWords):
+		return String
+	case containsAny(ws, numberWords):
+		return Number
+	case containsAny(ws, identifierWords):
+		return Identifier
+	case operatorNames[symbolic] || containsAny(ws, operatorWords):
+		return Operator
+	case isUpperWord(symbolic):
+		// Most likely a case insensitive keyword, e.g SELECT : S E L E C T;
+		return Keyword
+	}
+	return Other
+}
+
+func containsAny(ws []string, set map[string]bool) bool {
+	for _, w := range ws {
+		if set[w] {
+			return true
+		}
+	}
+	return false
+}
+
+// isWord returns true if s looks like a keyword, e.g "return".
+func isWord(s string) bool {
+	for i, r := range s {
+		if !(r == '_' || 'a' <= r && r <= 'z' || 'A' <= r && r <= 'Z' || i > 0 && '0' <= r && r <= '9') {
+			return false
+		}
+	}
+	return s != ""
+}
+
+// isUpperWord returns true if s is upper case letters, digits and underscores,
+// starting with a letter, e.g "END_IF".
+func isUpperWord(s string) bool {
+	for i, r := range s {
+		if !('A' <= r && r <= 'Z' || i > 0 && (r == '_' || '0' <= r && r <= '9')) {
+			return false
+		}
+	}
+	return s != ""
+}
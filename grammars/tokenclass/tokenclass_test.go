@@ -0,0 +1,204 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tokenclass
+
+import (
+	"testing"
+
+	"bramp.net/antlr4/grammars"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+
+	// Grammars used by the tests and overrides
+	_ "bramp.net/antlr4/c"
+	_ "bramp.net/antlr4/cobol85"
+	_ "bramp.net/antlr4/ecmascript"
+	_ "bramp.net/antlr4/json"
+	_ "bramp.net/antlr4/solidity"
+)
+
+func TestGuess(t *testing.T) {
+	tests := []struct {
+		symbolic string
+		literal  string
+		want     Class
+	}{
+		{"", "'return'", Keyword},
+		{"RETURN", "'return'", Keyword},
+		{"", "'{'", Operator},
+		{"", "'<='", Operator},
+		{"", "' '", Whitespace},
+		{"", "", Other},
+
+		{"LINE_COMMENT", "", Comment},
+		{"BlockComment", "", Comment},
+		{"WS", "", Whitespace},
+		{"Newline", "", Whitespace},
+		{"STRING", "", String},
+		{"StringLiteral", "", String},
+		{"NUMBER", "", Number},
+		{"HEX_FLOAT_LITERAL", "", Number},
+		{"IntegerLiteral", "", Number},
+		{"ID", "", Identifier},
+		{"Identifier", "", Identifier},
+		{"COMMA", "", Operator},
+		{"LPAREN", "", Operator},
+		{"SELECT", "", Keyword},
+		{"END_IF", "", Keyword},
+		{"Anything", "", Other},
+	}
+
+	for _, test := range tests {
+		if got := Guess(test.symbolic, test.literal); got != test.want {
+			t.Errorf("Guess(%q, %q) = %s, want %s", test.symbolic, test.literal, got, test.want)
+		}
+	}
+}
+
+func TestClassifier(t *testing.T) {
+	c := New(grammars.Lookup("json"))
+
+	input := `{"a": [1, true]}`
+	lexer := grammars.Lookup("json").NewLexer(antlr.NewInputStream(input))
+
+	var got []Class
+	for tok := lexer.NextToken(); tok.GetTokenType() != antlr.TokenEOF; tok = lexer.NextToken() {
+		got = append(got, c.Class(tok.GetTokenType()))
+	}
+
+	want := []Class{Operator, String, Operator, Operator, Number, Operator, Keyword, Operator, Operator}
+	if len(got) != len(want) {
+		t.Fatalf("Class(%q) = %v, want %v", input, got, want)
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Errorf("Class(%q)[%d] = %s, want %s", input, i, got[i], want[i])
+		}
+	}
+}
+
+// TestOverrides checks every override refers to a real token.
+func TestOverrides(t *testing.T) {
+	for name, tokens := range overrides {
+		g := grammars.Lookup(name)
+		if g == nil {
+			t.Errorf("overrides[%q] refers to an unknown grammar", name)
+			continue
+		}
+
+		symbolic := make(map[string]bool)
+		for _, s := range g.NewLexer(antlr.NewInputStream("")).GetSymbolicNames() {
+			symbolic[s] = true
+		}
+
+		for token := range tokens {
+			if !symbolic[token] {
+				t.Errorf("overrides[%q][%q] refers to an unknown token", name, token)
+			}
+		}
+	}
+}
+
+func TestScope(t *testing.T) {
+	json := New(grammars.Lookup("json"))
+	c := New(grammars.Lookup("c"))
+
+	tests := []struct {
+		classifier *Classifier
+		input      string
+		want       []string
+	}{
+		{json, `{"a": [1, null]}`, []string{
+			"punctuation.section.json", "string.quoted.json", "punctuation.separator.json", "punctuation.section.json",
+			"constant.numeric.json", "punctuation.separator.json", "constant.language.json", "punctuation.section.json",
+			"punctuation.section.json",
+		}},
+		{c, "if (x) return 1;", []string{
+			"keyword.control.c", "punctuation.section.c", "variable.other.c", "punctuation.section.c",
+			"keyword.control.c", "constant.numeric.c", "punctuation.separator.c",
+		}},
+	}
+
+	for _, test := range tests {
+		g := grammars.Lookup(test.classifier.lang)
+		lexer := g.NewLexer(antlr.NewInputStream(test.input))
+
+		var got []string
+		for tok := lexer.NextToken(); tok.GetTokenType() != antlr.TokenEOF; tok = lexer.NextToken() {
+			if scope := test.classifier.Scope(tok.GetTokenType()); scope != "" {
+				got = append(got, scope)
+			}
+		}
+
+		if len(got) != len(test.want) {
+			t.Errorf("Scope(%q) = %q, want %q", test.input, got, test.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != test.want[i] {
+				t.Errorf("Scope(%q)[%d] = %q, want %q", test.input, i, got[i], test.want[i])
+			}
+		}
+	}
+}
+
+func TestCommentScope(t *testing.T) {
+	// Comments are usually skipped by the lexer, so look them up by name.
+	tests := []struct {
+		grammar string
+		name    string
+		want    string
+	}{
+		{"c", "LineComment", "comment.line.c"},
+		{"c", "BlockComment", "comment.block.c"},
+		{"ecmascript", "SingleLineComment", "comment.line.ecmascript"},
+		{"ecmascript", "MultiLineComment", "comment.block.ecmascript"},
+	}
+	for _, test := range tests {
+		c := New(grammars.Lookup(test.grammar))
+
+		found := false
+		for ttype, s := range c.symbolic {
+			if s == test.name {
+				found = true
+				if got := c.Scope(ttype); got != test.want {
+					t.Errorf("%s: Scope(%s) = %q, want %q", test.grammar, test.name, got, test.want)
+				}
+			}
+		}
+		if !found {
+			t.Errorf("%s: token %s not found", test.grammar, test.name)
+		}
+	}
+}
+
+func TestFilter(t *testing.T) {
+	g := grammars.Lookup("json")
+	tokens, _ := g.Tokenize(antlr.NewInputStream(`{"a": 1, "b": "c"}`))
+
+	var got []string
+	for _, tok := range New(g).Filter(tokens, String) {
+		got = append(got, tok.GetText())
+	}
+
+	want := []string{`"a"`, `"b"`, `"c"`}
+	if len(got) != len(want) {
+		t.Fatalf("Filter(..., String) = %q, want %q", got, want)
+	}
+	for i := range got {
+		if got[i] != want[i] {
+			t.Errorf("Filter(..., String)[%d] = %q, want %q", i, got[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,80 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grammars
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// WithTrace writes each rule entered and exited, and each token consumed, to
+// w while parsing, like the -trace flag of ANTLR's TestRig (grun). This helps
+// debug why an input produces a surprising tree.
+func WithTrace(w io.Writer) Option {
+	return func(o *options) {
+		o.trace = w
+	}
+}
+
+// addTraceListener adds a traceListener writing to w. The generated parsers
+// embed a antlr.BaseParser, but AddParseListener isn't part of the
+// antlr.Parser interface.
+func addTraceListener(parser antlr.Parser, w io.Writer) {
+	if p, ok := parser.(interface {
+		AddParseListener(antlr.ParseTreeListener)
+	}); ok {
+		p.AddParseListener(&traceListener{w: w, parser: parser})
+	}
+}
+
+// traceListener is a antlr.ParseTreeListener, added to the parser with
+// AddParseListener, so it is called as the parse happens.
+type traceListener struct {
+	w      io.Writer
+	parser antlr.Parser
+}
+
+func (t *traceListener) ruleName(ctx antlr.RuleContext) string {
+	if ctx == nil {
+		return "<none>"
+	}
+	names := t.parser.GetRuleNames()
+	if i := ctx.GetRuleIndex(); i >= 0 && i < len(names) {
+		return names[i]
+	}
+	return fmt.Sprintf("<rule %d>", ctx.GetRuleIndex())
+}
+
+func (t *traceListener) lookahead() string {
+	return t.parser.GetTokenStream().LT(1).GetText()
+}
+
+func (t *traceListener) EnterEveryRule(ctx antlr.ParserRuleContext) {
+	fmt.Fprintf(t.w, "enter   %s, LT(1)=%s\n", t.ruleName(ctx), t.lookahead())
+}
+
+func (t *traceListener) ExitEveryRule(ctx antlr.ParserRuleContext) {
+	fmt.Fprintf(t.w, "exit    %s, LT(1)=%s\n", t.ruleName(ctx), t.lookahead())
+}
+
+func (t *traceListener) VisitTerminal(node antlr.TerminalNode) {
+	fmt.Fprintf(t.w, "consume %s rule %s\n", node.GetSymbol(), t.ruleName(t.parser.GetParserRuleContext()))
+}
+
+func (t *traceListener) VisitErrorNode(node antlr.ErrorNode) {
+	fmt.Fprintf(t.w, "error   %s rule %s\n", node.GetSymbol(), t.ruleName(t.parser.GetParserRuleContext()))
+}
@@ -0,0 +1,43 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grammars_test
+
+import (
+	"bytes"
+	"testing"
+
+	"bramp.net/antlr4/grammars"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+func TestWithTrace(t *testing.T) {
+	var buf bytes.Buffer
+	g := grammars.Lookup("json")
+	if _, err := g.Parse(antlr.NewInputStream("[1]"), grammars.WithTrace(&buf)); err != nil {
+		t.Fatalf("Parse(..., WithTrace) = %s", err)
+	}
+
+	for _, want := range []string{
+		"enter   json, LT(1)=[\n",
+		"enter   array, LT(1)=[\n",
+		"consume [@0,0:0='[',<5>,1:0] rule array\n",
+		"exit    array, LT(1)=<EOF>\n",
+		"exit    json, LT(1)=<EOF>\n",
+	} {
+		if !bytes.Contains(buf.Bytes(), []byte(want)) {
+			t.Errorf("Parse(..., WithTrace) wrote %q, want it to contain %q", buf.String(), want)
+		}
+	}
+}
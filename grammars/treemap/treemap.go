@@ -0,0 +1,139 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package treemap converts parse trees into plain maps, slices and strings,
+// so they can be used directly with text/template, encoding/json, or jq,
+// without writing a custom walker.
+//
+// Each rule becomes a map with its name, and its children, and each token
+// becomes its text. For example, with the JSON grammar, {"a": 1} becomes:
+//
+//	{"rule": "json", "children": [
+//		{"rule": "value", "children": [
+//			{"rule": "obj", "children": [
+//				"{",
+//				{"rule": "pair", "children": ["\"a\"", ":", {"rule": "value", "children": ["1"]}]},
+//				"}"
+//			]}
+//		]}
+//	]}
+package treemap // import "bramp.net/antlr4/grammars/treemap"
+
+import (
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+// Keys used in the maps.
+const (
+	RuleKey     = "rule"
+	ChildrenKey = "children"
+	TokenKey    = "token"
+	TextKey     = "text"
+	LineKey     = "line"
+	ColumnKey   = "column"
+	ErrorKey    = "error"
+)
+
+// Converter converts parse trees.
+type Converter struct {
+	// Recognizer is used to name the rules and tokens, normally the Parser
+	// that built the tree.
+	Recognizer antlr.Recognizer
+
+	// Tokens, if true, converts tokens into maps with their token name, text
+	// and position, instead of just their text. Rules also gain the line and
+	// column they start at.
+	Tokens bool
+}
+
+// Convert converts the tree using a Converter with the recognizer.
+func Convert(t antlr.Tree, recognizer antlr.Recognizer) interface{} {
+	c := &Converter{Recognizer: recognizer}
+	return c.Convert(t)
+}
+
+// Convert returns the tree as a map[string]interface{} if t is a rule, or a
+// string (or map, if Tokens is set) if t is a token. The EOF token is
+// omitted.
+func (c *Converter) Convert(t antlr.Tree) interface{} {
+	switch n := t.(type) {
+	case antlr.ErrorNode:
+		v := c.token(n.GetSymbol())
+		if m, ok := v.(map[string]interface{}); ok {
+			m[ErrorKey] = true
+		}
+		return v
+
+	case antlr.TerminalNode:
+		return c.token(n.GetSymbol())
+
+	case antlr.RuleContext:
+		children := []interface{}{}
+		for _, child := range t.GetChildren() {
+			if tn, ok := child.(antlr.TerminalNode); ok && tn.GetSymbol().GetTokenType() == antlr.TokenEOF {
+				continue
+			}
+			children = append(children, c.Convert(child))
+		}
+
+		m := map[string]interface{}{
+			RuleKey:     c.ruleName(n.GetRuleIndex()),
+			ChildrenKey: children,
+		}
+		if c.Tokens {
+			if prc, ok := t.(antlr.ParserRuleContext); ok && prc.GetStart() != nil {
+				m[LineKey] = prc.GetStart().GetLine()
+				m[ColumnKey] = prc.GetStart().GetColumn()
+			}
+		}
+		return m
+	}
+	return nil
+}
+
+func (c *Converter) token(tok antlr.Token) interface{} {
+	if !c.Tokens {
+		return tok.GetText()
+	}
+	return map[string]interface{}{
+		TokenKey:  c.tokenName(tok.GetTokenType()),
+		TextKey:   tok.GetText(),
+		LineKey:   tok.GetLine(),
+		ColumnKey: tok.GetColumn(),
+	}
+}
+
+func (c *Converter) ruleName(index int) string {
+	if c.Recognizer != nil {
+		if names := c.Recognizer.GetRuleNames(); index >= 0 && index < len(names) {
+			return names[index]
+		}
+	}
+	return ""
+}
+
+// tokenName returns the symbolic name for the token type, or failing that
+// its literal name, e.g "'{'".
+func (c *Converter) tokenName(ttype int) string {
+	if c.Recognizer == nil || ttype < 0 {
+		return ""
+	}
+	if names := c.Recognizer.GetSymbolicNames(); ttype < len(names) && names[ttype] != "" {
+		return names[ttype]
+	}
+	if names := c.Recognizer.GetLiteralNames(); ttype < len(names) {
+		return names[ttype]
+	}
+	return ""
+}
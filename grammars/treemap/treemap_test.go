@@ -0,0 +1,92 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package treemap
+
+import (
+	"bytes"
+	"testing"
+	"text/template"
+
+	"bramp.net/antlr4/json"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/kylelemons/godebug/pretty"
+)
+
+func parse(input string) (*json.JSONParser, antlr.ParseTree) {
+	lexer := json.NewJSONLexer(antlr.NewInputStream(input))
+	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	p := json.NewJSONParser(stream)
+	return p, p.Json()
+}
+
+type m map[string]interface{}
+type s []interface{}
+
+func TestConvert(t *testing.T) {
+	const input = `{"a": 1}`
+	p, tree := parse(input)
+
+	want := map[string]interface{}{"rule": "json", "children": s{
+		m{"rule": "value", "children": s{
+			m{"rule": "obj", "children": s{
+				"{",
+				m{"rule": "pair", "children": s{`"a"`, ":", m{"rule": "value", "children": s{"1"}}}},
+				"}",
+			}},
+		}},
+	}}
+
+	if diff := pretty.Compare(Convert(tree, p), want); diff != "" {
+		t.Errorf("Convert(%q) diff: (-got +want)\n%s", input, diff)
+	}
+}
+
+func TestConvertTokens(t *testing.T) {
+	const input = "[\n  true]"
+	p, tree := parse(input)
+
+	c := &Converter{Recognizer: p, Tokens: true}
+	got := c.Convert(tree.GetChild(0).GetChild(0))
+
+	want := map[string]interface{}{"rule": "array", "line": 1, "column": 0, "children": s{
+		m{"token": "'['", "text": "[", "line": 1, "column": 0},
+		m{"rule": "value", "line": 2, "column": 2, "children": s{
+			m{"token": "'true'", "text": "true", "line": 2, "column": 2},
+		}},
+		m{"token": "']'", "text": "]", "line": 2, "column": 6},
+	}}
+
+	if diff := pretty.Compare(got, want); diff != "" {
+		t.Errorf("Converter{Tokens: true}.Convert(%q) diff: (-got +want)\n%s", input, diff)
+	}
+}
+
+func TestTemplate(t *testing.T) {
+	const input = `{"a": 1, "b": 2}`
+	p, tree := parse(input)
+
+	tmpl := template.Must(template.New("").Parse(
+		`{{ range (index .children 0).children }}{{ range .children }}{{ if eq (printf "%T" .) "map[string]interface {}" }}{{ index .children 0 }} {{ end }}{{ end }}{{ end }}`))
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, Convert(tree, p)); err != nil {
+		t.Fatalf("Execute(...) err = %s, want nil", err)
+	}
+
+	const want = `"a" "b" `
+	if got := buf.String(); got != want {
+		t.Errorf("Execute(Convert(%q)) = %q, want %q", input, got, want)
+	}
+}
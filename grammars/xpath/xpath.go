@@ -0,0 +1,295 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package xpath finds nodes in a parse tree using the same path syntax as
+// the Java runtime's org.antlr.v4.runtime.tree.xpath package, which is
+// missing from the Go runtime.
+//
+// A path is a sequence of elements, each prefixed by "/" (a child of the
+// previous element) or "//" (any descendant of the previous element). An
+// element is a rule name, a token name, a literal token such as 'return', or
+// the wildcard "*". Prefixing an element with "!" inverts the match. For
+// example:
+//
+//	/prog/func         all funcs directly under the prog root
+//	//ID               all ID tokens anywhere in the tree
+//	//expr/primary/ID  all ID tokens under a primary under an expr
+//	//func/*/stat      all stats that are grandchildren of a func
+//	/prog/func/'def'   all 'def' literal tokens directly under a func
+//	//stat/!expr       all children of stat that are not an expr
+package xpath // import "bramp.net/antlr4/grammars/xpath"
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+const (
+	root     = "/"
+	anywhere = "//"
+	wildcard = "*"
+	bang     = "!"
+)
+
+// XPath is a compiled path expression.
+type XPath struct {
+	path     string
+	elements []*element
+}
+
+// element is one step of the path.
+type element struct {
+	name     string
+	anywhere bool // Match any descendant, instead of just the children
+	invert   bool
+
+	wildcard  bool
+	ruleIndex int // -1 if this isn't a rule
+	tokenType int // antlr.TokenInvalidType if this isn't a token
+}
+
+func (e *element) matches(t antlr.Tree) bool {
+	if e.wildcard {
+		return !e.invert
+	}
+
+	match := false
+	switch n := t.(type) {
+	case antlr.RuleContext:
+		match = n.GetRuleIndex() == e.ruleIndex
+	case antlr.TerminalNode:
+		match = n.GetSymbol().GetTokenType() == e.tokenType
+	}
+
+	return match != e.invert
+}
+
+// evaluate returns the nodes that match this element, given the children of
+// the current node.
+func (e *element) evaluate(children []antlr.Tree) []antlr.Tree {
+	var matches []antlr.Tree
+	var walk func(t antlr.Tree)
+	walk = func(t antlr.Tree) {
+		if e.matches(t) {
+			matches = append(matches, t)
+		}
+		if e.anywhere {
+			for _, c := range t.GetChildren() {
+				walk(c)
+			}
+		}
+	}
+
+	for _, c := range children {
+		walk(c)
+	}
+	return matches
+}
+
+func (e *element) String() string {
+	s := root
+	if e.anywhere {
+		s = anywhere
+	}
+	if e.invert {
+		s += bang
+	}
+	return s + e.name
+}
+
+// Compile parses a path expression, looking up the rule and token names
+// with the recognizer (normally the Parser that built the tree).
+func Compile(path string, recognizer antlr.Recognizer) (*XPath, error) {
+	words, err := split(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(words) == 0 {
+		return nil, fmt.Errorf("xpath: empty path")
+	}
+
+	x := &XPath{path: path}
+	for i := 0; i < len(words); i++ {
+		e := &element{
+			ruleIndex: -1,
+			tokenType: antlr.TokenInvalidType,
+		}
+
+		switch words[i] {
+		case root, anywhere:
+			e.anywhere = words[i] == anywhere
+			i++
+		}
+
+		if i < len(words) && words[i] == bang {
+			e.invert = true
+			i++
+		}
+
+		if i >= len(words) {
+			return nil, fmt.Errorf("xpath: missing path element at end of path %q", path)
+		}
+
+		e.name = words[i]
+		if err := e.resolve(recognizer); err != nil {
+			return nil, fmt.Errorf("xpath: %s in path %q", err, path)
+		}
+
+		x.elements = append(x.elements, e)
+	}
+
+	return x, nil
+}
+
+// resolve looks up the rule index or token type for this element's name.
+func (e *element) resolve(recognizer antlr.Recognizer) error {
+	switch {
+	case e.name == wildcard:
+		e.wildcard = true
+
+	case strings.HasPrefix(e.name, "'"):
+		e.tokenType = indexOf(recognizer.GetLiteralNames(), e.name)
+		if e.tokenType < 0 {
+			return fmt.Errorf("%s isn't a valid literal token", e.name)
+		}
+
+	case isUpper(e.name[0]):
+		e.tokenType = indexOf(recognizer.GetSymbolicNames(), e.name)
+		if e.tokenType < 0 {
+			return fmt.Errorf("%s isn't a valid token name", e.name)
+		}
+
+	default:
+		e.ruleIndex = indexOf(recognizer.GetRuleNames(), e.name)
+		if e.ruleIndex < 0 {
+			return fmt.Errorf("%s isn't a valid rule name", e.name)
+		}
+	}
+	return nil
+}
+
+// MustCompile is like Compile but panics if the path cannot be compiled.
+func MustCompile(path string, recognizer antlr.Recognizer) *XPath {
+	x, err := Compile(path, recognizer)
+	if err != nil {
+		panic(err)
+	}
+	return x
+}
+
+// Evaluate returns all the nodes in the tree that match the path, in the order
+// they were found.
+func (x *XPath) Evaluate(t antlr.ParseTree) []antlr.ParseTree {
+	work := []antlr.Tree{t}
+	for i, e := range x.elements {
+		var next []antlr.Tree
+		seen := make(map[antlr.Tree]bool)
+
+		for _, node := range work {
+			children := node.GetChildren()
+			if i == 0 {
+				// The first element is evaluated against a imaginary root
+				// node, whose only child is t.
+				children = []antlr.Tree{node}
+			}
+
+			for _, match := range e.evaluate(children) {
+				if !seen[match] {
+					seen[match] = true
+					next = append(next, match)
+				}
+			}
+		}
+		work = next
+	}
+
+	var results []antlr.ParseTree
+	for _, t := range work {
+		results = append(results, t.(antlr.ParseTree))
+	}
+	return results
+}
+
+func (x *XPath) String() string {
+	return x.path
+}
+
+// FindAll compiles the path, and returns all the nodes in the tree that match.
+func FindAll(t antlr.ParseTree, path string, recognizer antlr.Recognizer) ([]antlr.ParseTree, error) {
+	x, err := Compile(path, recognizer)
+	if err != nil {
+		return nil, err
+	}
+	return x.Evaluate(t), nil
+}
+
+// split breaks the path into its separators and words.
+func split(path string) ([]string, error) {
+	var words []string
+	for i := 0; i < len(path); {
+		c := path[i]
+		switch {
+		case strings.HasPrefix(path[i:], anywhere):
+			words = append(words, anywhere)
+			i += len(anywhere)
+
+		case c == '/' || c == '!' || c == '*':
+			words = append(words, string(c))
+			i++
+
+		case c == '\'':
+			end := strings.IndexByte(path[i+1:], '\'')
+			if end < 0 {
+				return nil, fmt.Errorf("xpath: unterminated literal at index %d in path %q", i, path)
+			}
+			end += i + 2
+			words = append(words, path[i:end])
+			i = end
+
+		case isIdentifier(c):
+			start := i
+			for i < len(path) && (isIdentifier(path[i]) || isDigit(path[i])) {
+				i++
+			}
+			words = append(words, path[start:i])
+
+		default:
+			return nil, fmt.Errorf("xpath: invalid character %q at index %d in path %q", c, i, path)
+		}
+	}
+	return words, nil
+}
+
+func indexOf(names []string, name string) int {
+	for i, n := range names {
+		if n == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func isUpper(c byte) bool {
+	return 'A' <= c && c <= 'Z'
+}
+
+func isDigit(c byte) bool {
+	return '0' <= c && c <= '9'
+}
+
+func isIdentifier(c byte) bool {
+	return c == '_' || ('a' <= c && c <= 'z') || isUpper(c)
+}
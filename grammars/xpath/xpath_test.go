@@ -0,0 +1,91 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package xpath
+
+import (
+	"testing"
+
+	"bramp.net/antlr4/json"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"github.com/kylelemons/godebug/pretty"
+)
+
+const input = `{"a": 1, "b": [true, {"c": "d"}], "e": null}`
+
+// compact is input without the whitespace, as returned by GetText.
+const compact = `{"a":1,"b":[true,{"c":"d"}],"e":null}`
+
+func parse(input string) (*json.JSONParser, antlr.ParseTree) {
+	lexer := json.NewJSONLexer(antlr.NewInputStream(input))
+	stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+	p := json.NewJSONParser(stream)
+	return p, p.Json()
+}
+
+func TestEvaluate(t *testing.T) {
+	tests := []struct {
+		path string
+		want []string
+	}{
+		{"/json", []string{compact}},
+		{"/json/value/obj/pair/STRING", []string{`"a"`, `"b"`, `"e"`}},
+		{"//pair/STRING", []string{`"a"`, `"b"`, `"c"`, `"e"`}},
+		{"//array//STRING", []string{`"c"`, `"d"`}},
+		{"//value/'true'", []string{"true"}},
+		{"//value/'null'", []string{"null"}},
+		{"/json/*/*/pair/!STRING", []string{":", "1", ":", `[true,{"c":"d"}]`, ":", "null"}},
+		{"//obj//obj", []string{`{"c":"d"}`}},
+		{"//array/*", []string{"[", "true", ",", `{"c":"d"}`, "]"}},
+		{"/value", nil},
+	}
+
+	for _, test := range tests {
+		p, tree := parse(input)
+		matches, err := FindAll(tree, test.path, p)
+		if err != nil {
+			t.Errorf("FindAll(%q) err = %s, want nil", test.path, err)
+			continue
+		}
+
+		var got []string
+		for _, m := range matches {
+			got = append(got, m.GetText())
+		}
+
+		if diff := pretty.Compare(got, test.want); diff != "" {
+			t.Errorf("FindAll(%q) diff: (-got +want)\n%s", test.path, diff)
+		}
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	tests := []string{
+		"",
+		"/",
+		"//!",
+		"/json/nope",
+		"//NOPE",
+		"//'nope'",
+		"//'unterminated",
+		"/json/$",
+	}
+
+	p, _ := parse(input)
+	for _, path := range tests {
+		if _, err := Compile(path, p); err == nil {
+			t.Errorf("Compile(%q) err = nil, want error", path)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+// ANTLRVersion is the version of ANTLR the grammars are generated with. It
+// must match the Go runtime, otherwise the generated code may not work.
+const ANTLRVersion = "4.7.2"
+
+// ANTLRJar returns where the Makefile expects the ANTLRVersion jar, the same
+// place Maven puts it.
+func ANTLRJar() string {
+	home := os.Getenv("HOME")
+	if home == "" && runtime.GOOS == "windows" {
+		home = os.Getenv("USERPROFILE")
+	}
+	return filepath.Join(home, ".m2", "repository", "org", "antlr", "antlr4", ANTLRVersion, "antlr4-"+ANTLRVersion+"-complete.jar")
+}
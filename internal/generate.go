@@ -0,0 +1,593 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"strconv"
+	"strings"
+)
+
+// Generator generates sentences from a grammar's g4 files, for example to
+// create example inputs covering the rules the existing examples don't. The
+// sentences are a best effort, ignoring actions, predicates and lexer modes,
+// so should be checked by parsing them.
+type Generator struct {
+	// MaxDepth is how deeply rules are expanded before the generator picks
+	// the alternatives that finish soonest.
+	MaxDepth int
+
+	rand  *rand.Rand
+	rules map[string]*genRule
+}
+
+type genRule struct {
+	name  string
+	lexer bool
+	node  *genNode
+	cost  int // Minimum depth of rules needed to expand this rule
+}
+
+type genKind int
+
+const (
+	genAlts genKind = iota // One of the children
+	genSeq                 // All the children, in order
+	genRef                 // A rule or token
+	genText                // Literal text
+	genSet                 // One character from the set
+	genNot                 // One character not in the set
+	genAny                 // Any character, or token
+)
+
+// genNode is a element of a rule's body.
+type genNode struct {
+	kind     genKind
+	text     string // Name of the genRef, or the genText
+	set      []runeRange
+	children []*genNode
+	min, max int // Number of repetitions, max is -1 for unbounded
+}
+
+type runeRange struct {
+	lo, hi rune
+}
+
+// infinity is the cost of rules that can't be expanded.
+const infinity = 1 << 30
+
+// NewGenerator returns a Generator for the grammar defined by the g4 files,
+// seeded so it always generates the same sentences.
+func NewGenerator(filenames []string, seed int64) (*Generator, error) {
+	var files []*g4File
+	for _, filename := range filenames {
+		src, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return nil, err
+		}
+		f, err := parseG4Source(filename, src)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+	}
+	return newGenerator(files, seed), nil
+}
+
+func newGenerator(files []*g4File, seed int64) *Generator {
+	g := &Generator{
+		MaxDepth: 10,
+		rand:     rand.New(rand.NewSource(seed)),
+		rules:    make(map[string]*genRule),
+	}
+	for _, f := range files {
+		for _, r := range f.rules {
+			node, _ := parseAlts(r.body, 0)
+			g.rules[r.name] = &genRule{
+				name:  r.name,
+				lexer: r.lexer,
+				node:  node,
+				cost:  infinity,
+			}
+		}
+	}
+	g.computeCosts()
+	return g
+}
+
+// parseAlts parses the alternatives starting at tokens[i], up to the closing
+// paren or end of the tokens, returning the index it stopped at.
+func parseAlts(tokens []g4Token, i int) (*genNode, int) {
+	alts := &genNode{kind: genAlts, min: 1, max: 1}
+	seq := &genNode{kind: genSeq, min: 1, max: 1}
+	for ; i < len(tokens); i++ {
+		t := tokens[i]
+		var n *genNode
+		switch {
+		case t.kind == g4Punct && t.text == ")":
+			alts.children = append(alts.children, seq)
+			return alts, i
+
+		case t.kind == g4Punct && t.text == "|":
+			alts.children = append(alts.children, seq)
+			seq = &genNode{kind: genSeq, min: 1, max: 1}
+			continue
+
+		case t.kind == g4Punct && t.text == "->":
+			// Skip the lexer commands, up to the end of the alternative.
+			for i+1 < len(tokens) && tokens[i+1].text != "|" && tokens[i+1].text != ")" {
+				i++
+			}
+			continue
+
+		case t.kind == g4Punct && t.text == "#":
+			i++ // Skip the alternative's label
+			continue
+
+		case t.kind == g4Punct && t.text == "<":
+			i = skipTo(tokens, i, ">") // Skip element options, e.g <assoc=right>
+			continue
+
+		case t.kind == g4Action:
+			if i+1 < len(tokens) && tokens[i+1].text == "?" {
+				i++ // A semantic predicate
+			}
+			continue
+
+		case t.kind == g4ID && i+1 < len(tokens) && (tokens[i+1].text == "=" || tokens[i+1].text == "+="):
+			i++ // Skip the label
+			continue
+
+		case t.kind == g4ID:
+			n = &genNode{kind: genRef, text: t.text}
+
+		case t.kind == g4Literal:
+			text := unescapeG4(t.text[1 : len(t.text)-1])
+			if i+3 < len(tokens) && tokens[i+1].text == "." && tokens[i+2].text == "." && tokens[i+3].kind == g4Literal {
+				// A range, e.g 'a'..'z'
+				hi := unescapeG4(tokens[i+3].text[1 : len(tokens[i+3].text)-1])
+				n = &genNode{kind: genSet, set: []runeRange{{firstRune(text), firstRune(hi)}}}
+				i += 3
+			} else {
+				n = &genNode{kind: genText, text: text}
+			}
+
+		case t.kind == g4Set:
+			n = &genNode{kind: genSet, set: parseSet(t.text[1 : len(t.text)-1])}
+
+		case t.kind == g4Punct && t.text == ".":
+			n = &genNode{kind: genAny}
+
+		case t.kind == g4Punct && t.text == "~":
+			var not *genNode
+			if not, i = parseNot(tokens, i+1); not == nil {
+				continue
+			}
+			n = not
+
+		case t.kind == g4Punct && t.text == "(":
+			n, i = parseAlts(tokens, i+1)
+
+		default:
+			continue
+		}
+
+		n.min, n.max = 1, 1
+		if i+1 < len(tokens) && tokens[i+1].kind == g4Punct {
+			switch tokens[i+1].text {
+			case "?":
+				n.min, n.max = 0, 1
+				i++
+			case "*":
+				n.min, n.max = 0, -1
+				i++
+			case "+":
+				n.min, n.max = 1, -1
+				i++
+			}
+			if n.min != 1 || n.max != 1 {
+				if i+1 < len(tokens) && tokens[i+1].text == "?" {
+					i++ // Non-greedy
+				}
+			}
+		}
+		seq.children = append(seq.children, n)
+	}
+	alts.children = append(alts.children, seq)
+	return alts, i
+}
+
+// parseNot parses the set, literal, or parenthesised alternatives of sets and
+// literals at tokens[i], and returns a genNot for the characters not in it.
+func parseNot(tokens []g4Token, i int) (*genNode, int) {
+	if i >= len(tokens) {
+		return nil, i
+	}
+	n := &genNode{kind: genNot}
+	switch t := tokens[i]; {
+	case t.kind == g4Set:
+		n.set = parseSet(t.text[1 : len(t.text)-1])
+	case t.kind == g4Literal:
+		r := firstRune(unescapeG4(t.text[1 : len(t.text)-1]))
+		n.set = []runeRange{{r, r}}
+	case t.kind == g4Punct && t.text == "(":
+		alts, end := parseAlts(tokens, i+1)
+		var collect func(n *genNode)
+		collect = func(c *genNode) {
+			switch c.kind {
+			case genSet:
+				n.set = append(n.set, c.set...)
+			case genText:
+				r := firstRune(c.text)
+				n.set = append(n.set, runeRange{r, r})
+			}
+			for _, child := range c.children {
+				collect(child)
+			}
+		}
+		collect(alts)
+		i = end
+	default:
+		return nil, i
+	}
+	return n, i
+}
+
+// unescapeG4 returns the text of a literal, or set, with escapes replaced.
+// Unicode properties, e.g \p{L}, are replaced with a letter.
+func unescapeG4(s string) string {
+	var out []rune
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		if runes[i] != '\\' || i+1 >= len(runes) {
+			out = append(out, runes[i])
+			continue
+		}
+		i++
+		switch runes[i] {
+		case 'n':
+			out = append(out, '\n')
+		case 'r':
+			out = append(out, '\r')
+		case 't':
+			out = append(out, '\t')
+		case 'b':
+			out = append(out, '\b')
+		case 'f':
+			out = append(out, '\f')
+		case 'u':
+			hex := ""
+			if i+1 < len(runes) && runes[i+1] == '{' {
+				end := i + 2
+				for end < len(runes) && runes[end] != '}' {
+					end++
+				}
+				hex, i = string(runes[i+2:min(end, len(runes))]), end
+			} else if i+4 < len(runes) {
+				hex, i = string(runes[i+1:i+5]), i+4
+			}
+			if r, err := strconv.ParseUint(hex, 16, 32); err == nil {
+				out = append(out, rune(r))
+			}
+		case 'p', 'P':
+			if i+1 < len(runes) && runes[i+1] == '{' {
+				for i < len(runes) && runes[i] != '}' {
+					i++
+				}
+			}
+			out = append(out, 'a')
+		default:
+			out = append(out, runes[i])
+		}
+	}
+	return string(out)
+}
+
+// parseSet parses the contents of a set, e.g a-zA-Z_.
+func parseSet(s string) []runeRange {
+	runes := []rune(unescapeSetDash(s))
+	var set []runeRange
+	for i := 0; i < len(runes); i++ {
+		lo := runes[i]
+		if lo == dash {
+			lo = '-'
+		}
+		hi := lo
+		if i+2 < len(runes) && runes[i+1] == '-' {
+			hi = runes[i+2]
+			if hi == dash {
+				hi = '-'
+			}
+			i += 2
+		}
+		if hi < lo {
+			lo, hi = hi, lo
+		}
+		set = append(set, runeRange{lo, hi})
+	}
+	return set
+}
+
+// dash is a placeholder for a escaped \- in a set, so it isn't mistaken for
+// a range.
+const dash = '￿'
+
+func unescapeSetDash(s string) string {
+	return unescapeG4(strings.Replace(s, `\-`, string(dash), -1))
+}
+
+func firstRune(s string) rune {
+	for _, r := range s {
+		return r
+	}
+	return 'a'
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func inSet(set []runeRange, r rune) bool {
+	for _, rr := range set {
+		if rr.lo <= r && r <= rr.hi {
+			return true
+		}
+	}
+	return false
+}
+
+// computeCosts sets the cost of each rule, iterating until it converges.
+func (g *Generator) computeCosts() {
+	for changed := true; changed; {
+		changed = false
+		for _, r := range g.rules {
+			if c := g.nodeCost(r.node); c < r.cost {
+				r.cost = c
+				changed = true
+			}
+		}
+	}
+}
+
+// nodeCost returns the minimum depth of rules needed to expand the node.
+func (g *Generator) nodeCost(n *genNode) int {
+	if n.min == 0 {
+		return 0
+	}
+	switch n.kind {
+	case genRef:
+		r := g.rules[n.text]
+		if r == nil {
+			return 0 // Undefined, e.g EOF, or a token from a tokens block.
+		}
+		if r.cost == infinity {
+			return infinity
+		}
+		return r.cost + 1
+	case genAlts:
+		cost := infinity
+		for _, c := range n.children {
+			if cc := g.nodeCost(c); cc < cost {
+				cost = cc
+			}
+		}
+		return cost
+	case genSeq:
+		cost := 0
+		for _, c := range n.children {
+			if cc := g.nodeCost(c); cc > cost {
+				cost = cc
+			}
+		}
+		return cost
+	}
+	return 0
+}
+
+// Generate returns a sentence, starting from the entry rule, which expands
+// the target rule at least once. If target is empty any sentence is returned.
+// The tokens of the sentence are separated by a single space.
+func (g *Generator) Generate(entry, target string) (string, error) {
+	r := g.rules[entry]
+	if r == nil {
+		return "", fmt.Errorf("unknown rule %q", entry)
+	}
+	if r.cost == infinity {
+		return "", fmt.Errorf("rule %q can never finish", entry)
+	}
+
+	s := &sentence{
+		g:       g,
+		reached: target == "",
+		dist:    g.distances(target),
+	}
+	if !s.reached && s.dist[entry] == infinity {
+		return "", fmt.Errorf("rule %q is not reachable from %q", target, entry)
+	}
+	s.target = target
+	s.parserRef(entry, 0)
+	if !s.reached {
+		return "", fmt.Errorf("failed to reach rule %q", target)
+	}
+	return strings.Join(s.tokens, " "), nil
+}
+
+// distances returns the number of rules that must be expanded from each
+// rule to reach the target.
+func (g *Generator) distances(target string) map[string]int {
+	dist := make(map[string]int)
+	for name := range g.rules {
+		dist[name] = infinity
+	}
+	if target == "" {
+		return dist
+	}
+	dist[target] = 0
+	for changed := true; changed; {
+		changed = false
+		for name, r := range g.rules {
+			if d := g.nodeDist(r.node, dist); d < infinity && d+1 < dist[name] {
+				dist[name] = d + 1
+				changed = true
+			}
+		}
+	}
+	return dist
+}
+
+// nodeDist returns the distance to the target of the closest rule the node
+// refers to.
+func (g *Generator) nodeDist(n *genNode, dist map[string]int) int {
+	if n.kind == genRef {
+		if d, ok := dist[n.text]; ok {
+			return d
+		}
+		return infinity
+	}
+	best := infinity
+	for _, c := range n.children {
+		if d := g.nodeDist(c, dist); d < best {
+			best = d
+		}
+	}
+	return best
+}
+
+// sentence is a sentence being generated.
+type sentence struct {
+	g       *Generator
+	target  string
+	reached bool
+	dist    map[string]int
+	tokens  []string
+}
+
+func (s *sentence) parserRef(name string, depth int) {
+	if name == s.target {
+		s.reached = true
+	}
+	r := s.g.rules[name]
+	if r == nil {
+		return
+	}
+	if r.lexer {
+		var text []rune
+		s.lexerNode(r.node, depth, &text)
+		s.tokens = append(s.tokens, string(text))
+		return
+	}
+	s.parserNode(r.node, depth+1)
+}
+
+// repeat returns how many times to repeat the node.
+func (s *sentence) repeat(n *genNode, depth int, towards bool) int {
+	if towards {
+		if n.min > 1 {
+			return n.min
+		}
+		return 1
+	}
+	if depth > s.g.MaxDepth || n.min == n.max {
+		return n.min
+	}
+	max := n.max
+	if max < 0 {
+		max = n.min + 2
+	}
+	return n.min + s.g.rand.Intn(max-n.min+1)
+}
+
+// pick returns the alternative to generate.
+func (s *sentence) pick(n *genNode, depth int) *genNode {
+	if !s.reached {
+		best, bestDist := -1, infinity
+		for i, c := range n.children {
+			if d := s.g.nodeDist(c, s.dist); d < bestDist {
+				best, bestDist = i, d
+			}
+		}
+		if best >= 0 {
+			return n.children[best]
+		}
+	}
+	if depth > s.g.MaxDepth {
+		best, bestCost := 0, infinity
+		for i, c := range n.children {
+			if cost := s.g.nodeCost(c); cost < bestCost {
+				best, bestCost = i, cost
+			}
+		}
+		return n.children[best]
+	}
+	return n.children[s.g.rand.Intn(len(n.children))]
+}
+
+func (s *sentence) parserNode(n *genNode, depth int) {
+	towards := !s.reached && s.g.nodeDist(n, s.dist) < infinity
+	for i := s.repeat(n, depth, towards); i > 0; i-- {
+		switch n.kind {
+		case genAlts:
+			s.parserNode(s.pick(n, depth), depth)
+		case genSeq:
+			for _, c := range n.children {
+				s.parserNode(c, depth)
+			}
+		case genRef:
+			s.parserRef(n.text, depth)
+		case genText:
+			s.tokens = append(s.tokens, n.text)
+		}
+	}
+}
+
+func (s *sentence) lexerNode(n *genNode, depth int, text *[]rune) {
+	for i := s.repeat(n, depth, false); i > 0; i-- {
+		switch n.kind {
+		case genAlts:
+			s.lexerNode(s.pick(n, depth), depth, text)
+		case genSeq:
+			for _, c := range n.children {
+				s.lexerNode(c, depth, text)
+			}
+		case genRef:
+			if r := s.g.rules[n.text]; r != nil {
+				s.lexerNode(r.node, depth+1, text)
+			}
+		case genText:
+			*text = append(*text, []rune(n.text)...)
+		case genSet:
+			if len(n.set) > 0 {
+				rr := n.set[s.g.rand.Intn(len(n.set))]
+				span := int(rr.hi-rr.lo) + 1
+				if span > 26 {
+					span = 26
+				}
+				*text = append(*text, rr.lo+rune(s.g.rand.Intn(span)))
+			}
+		case genNot, genAny:
+			for _, r := range "axz0_ -" {
+				if !inSet(n.set, r) {
+					*text = append(*text, r)
+					break
+				}
+			}
+		}
+	}
+}
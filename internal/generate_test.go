@@ -0,0 +1,103 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	f, err := parseG4Source("Lint.g4", []byte(lintGrammar))
+	if err != nil {
+		t.Fatalf("parseG4Source(...) err = %s, want nil", err)
+	}
+	g := newGenerator([]*g4File{f}, 1)
+
+	// Every token the file rule could generate.
+	token := regexp.MustCompile(`^([a-zA-Z_][a-zA-Z_0-9]*|"[^"]*"|[=;+])$`)
+
+	for _, target := range []string{"", "file", "item", "value"} {
+		for i := 0; i < 20; i++ {
+			got, err := g.Generate("file", target)
+			if err != nil {
+				t.Errorf("Generate(%q, %q) err = %s, want nil", "file", target, err)
+				continue
+			}
+			if target == "item" || target == "value" {
+				if !strings.HasSuffix(got, ";") && !strings.Contains(got, `"`) {
+					t.Errorf("Generate(%q, %q) = %q, want it to contain a %s", "file", target, got, target)
+				}
+			}
+			for _, tok := range strings.Fields(got) {
+				if !token.MatchString(tok) {
+					t.Errorf("Generate(%q, %q) = %q, contains unexpected token %q", "file", target, got, tok)
+				}
+			}
+		}
+	}
+}
+
+func TestGenerateErrors(t *testing.T) {
+	f, err := parseG4Source("Lint.g4", []byte(lintGrammar))
+	if err != nil {
+		t.Fatalf("parseG4Source(...) err = %s, want nil", err)
+	}
+	g := newGenerator([]*g4File{f}, 1)
+
+	tests := []struct {
+		entry, target string
+	}{
+		{"missing", ""},
+		{"loop", ""},       // Never finishes
+		{"file", "unused"}, // Not reachable
+	}
+	for _, test := range tests {
+		if got, err := g.Generate(test.entry, test.target); err == nil {
+			t.Errorf("Generate(%q, %q) = %q, want error", test.entry, test.target, got)
+		}
+	}
+}
+
+func TestGenerateLexer(t *testing.T) {
+	f, err := parseG4Source("Lint.g4", []byte(lintGrammar))
+	if err != nil {
+		t.Fatalf("parseG4Source(...) err = %s, want nil", err)
+	}
+	g := newGenerator([]*g4File{f}, 1)
+
+	tests := []struct {
+		rule string
+		want *regexp.Regexp
+	}{
+		{"ID", regexp.MustCompile(`^[a-zA-Z_][a-zA-Z_0-9]*$`)},
+		{"STRING", regexp.MustCompile(`^"[^"]*"$`)},
+		{"COMMENT", regexp.MustCompile(`^//[^\r\n]*$`)},
+		{"WS", regexp.MustCompile(`^[ \t\r\n]+$`)},
+	}
+	for _, test := range tests {
+		for i := 0; i < 20; i++ {
+			got, err := g.Generate(test.rule, "")
+			if err != nil {
+				t.Errorf("Generate(%q, \"\") err = %s, want nil", test.rule, err)
+				continue
+			}
+			if !test.want.MatchString(got) {
+				t.Errorf("Generate(%q, \"\") = %q, want match for %s", test.rule, got, test.want)
+			}
+		}
+	}
+}
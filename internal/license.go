@@ -0,0 +1,139 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"bufio"
+	"os"
+	"strings"
+)
+
+// License is the license found in the header comment of a g4 file.
+type License struct {
+	SPDX string // SPDX identifier, e.g "MIT", or empty if not recognised
+	Text string
+}
+
+const spdxPrefix = "SPDX-License-Identifier:"
+
+// spdxMatchers identifies the license by phrases that appear in its text. The
+// first match wins, so more specific licenses are listed first.
+var spdxMatchers = []struct {
+	id      string
+	phrases []string
+}{
+	{"Apache-2.0", []string{"Apache License", "Version 2.0"}},
+	{"BSD-3-Clause", []string{"Redistribution and use in source and binary forms", "Neither the name"}},
+	{"BSD-2-Clause", []string{"Redistribution and use in source and binary forms"}},
+	{"MIT", []string{"Permission is hereby granted, free of charge"}},
+	{"EPL-1.0", []string{"Eclipse Public License"}},
+	{"MPL-2.0", []string{"Mozilla Public License", "2.0"}},
+	{"LGPL-2.1-or-later", []string{"GNU Lesser General Public License"}},
+	{"GPL-3.0-or-later", []string{"GNU General Public License", "version 3"}},
+}
+
+// licenseWords are the words that distinguish a license from any other
+// header comment, such as a description of the grammar.
+var licenseWords = []string{"license", "licence", "copyright", "permission", "redistribution"}
+
+// ParseLicense returns the license in the header comment of the g4 file, or
+// nil if there is none.
+func ParseLicense(path string) (*License, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var lines []string
+	inBlock := false
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		switch {
+		case inBlock:
+			if end := strings.Index(line, "*/"); end >= 0 {
+				line, inBlock = line[:end], false
+			}
+			line = strings.TrimPrefix(strings.TrimPrefix(line, "*"), " ")
+
+		case strings.HasPrefix(line, "/*"):
+			line, inBlock = strings.TrimSpace(strings.TrimLeft(line[2:], "*")), true
+			if end := strings.Index(line, "*/"); end >= 0 {
+				line, inBlock = line[:end], false
+			}
+
+		case strings.HasPrefix(line, "//"):
+			line = strings.TrimPrefix(line[2:], " ")
+
+		case line == "":
+			// Blank lines between comments
+
+		default:
+			// The end of the header
+			return newLicense(lines), nil
+		}
+		lines = append(lines, strings.TrimRight(line, " \t"))
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return newLicense(lines), nil
+}
+
+// newLicense returns the License in the header comment lines, or nil if the
+// lines aren't a license.
+func newLicense(lines []string) *License {
+	text := strings.TrimSpace(strings.Join(lines, "\n"))
+
+	lower := strings.ToLower(text)
+	isLicense := false
+	for _, word := range licenseWords {
+		if strings.Contains(lower, word) {
+			isLicense = true
+			break
+		}
+	}
+	if !isLicense {
+		return nil
+	}
+
+	l := &License{Text: text}
+
+	// Match phrases ignoring how the text was wrapped.
+	flat := strings.Join(strings.Fields(text), " ")
+	if i := strings.Index(flat, spdxPrefix); i >= 0 {
+		if id := strings.Fields(flat[i+len(spdxPrefix):]); len(id) > 0 {
+			l.SPDX = id[0]
+			return l
+		}
+	}
+	for _, m := range spdxMatchers {
+		if containsAll(flat, m.phrases) {
+			l.SPDX = m.id
+			break
+		}
+	}
+	return l
+}
+
+func containsAll(s string, subs []string) bool {
+	for _, sub := range subs {
+		if !strings.Contains(s, sub) {
+			return false
+		}
+	}
+	return true
+}
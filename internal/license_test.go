@@ -0,0 +1,92 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+)
+
+func TestParseLicense(t *testing.T) {
+	tests := []struct {
+		g4   string
+		want *License
+	}{
+		{
+			g4: `/*
+ * Copyright (c) 2017 Someone
+ *
+ * Permission is hereby granted, free of charge, to any person obtaining a
+ * copy of this software.
+ */
+grammar Test;
+`,
+			want: &License{
+				SPDX: "MIT",
+				Text: "Copyright (c) 2017 Someone\n\nPermission is hereby granted, free of charge, to any person obtaining a\ncopy of this software.",
+			},
+		}, {
+			g4: `// Copyright 2017 Someone
+// Redistribution and use in source and binary
+// forms, with or without modification, are permitted.
+
+/** A grammar for testing */
+grammar Test;
+`,
+			want: &License{
+				SPDX: "BSD-2-Clause",
+				Text: "Copyright 2017 Someone\nRedistribution and use in source and binary\nforms, with or without modification, are permitted.\n\nA grammar for testing",
+			},
+		}, {
+			g4: "// SPDX-License-Identifier: BSD-3-Clause\ngrammar Test;\n",
+			want: &License{
+				SPDX: "BSD-3-Clause",
+				Text: "SPDX-License-Identifier: BSD-3-Clause",
+			},
+		}, {
+			g4:   "/* Licensed under some bespoke terms */ grammar Test;\n",
+			want: &License{Text: "Licensed under some bespoke terms"},
+		}, {
+			g4:   "/** A grammar for testing */\ngrammar Test;\n// Copyright 2017\n",
+			want: nil,
+		},
+	}
+
+	dir, err := ioutil.TempDir("", "license")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, test := range tests {
+		filename := filepath.Join(dir, "Test.g4")
+		if err := ioutil.WriteFile(filename, []byte(test.g4), 0644); err != nil {
+			t.Fatal(err)
+		}
+
+		got, err := ParseLicense(filename)
+		if err != nil {
+			t.Errorf("ParseLicense(%q) err = %s, want nil", test.g4, err)
+			continue
+		}
+		if diff := pretty.Compare(got, test.want); diff != "" {
+			t.Errorf("ParseLicense(%q) diff: (-got +want)\n%s", test.g4, diff)
+		}
+	}
+}
@@ -0,0 +1,528 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// Problem is a suspicious construct found in a grammar by Lint.
+type Problem struct {
+	Filename string
+	Line     int
+	Check    string // One of "unused-rule", "unreachable-token", "left-recursion" or "action"
+	Msg      string
+}
+
+func (p Problem) String() string {
+	return fmt.Sprintf("%s:%d: %s (%s)", p.Filename, p.Line, p.Msg, p.Check)
+}
+
+// Lint checks the project's g4 files for unused rules, tokens that can never
+// reach the parser, suspicious left recursion, and actions written for a
+// specific target language (which the Go target ignores or fails on).
+func (p *Project) Lint() ([]Problem, error) {
+	var files []*g4File
+	for _, filename := range p.Includes {
+		src, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return nil, err
+		}
+		f, err := parseG4Source(filename, src)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+	}
+	return lint(p.EntryPoint, files), nil
+}
+
+// g4Kind is the kind of a g4Token.
+type g4Kind int
+
+const (
+	g4ID      g4Kind = iota // Rule, token or command name
+	g4Literal               // 'quoted'
+	g4Set                   // [a-z], or rule arguments
+	g4Action                // {...}, also used for options and tokens blocks
+	g4Punct                 // Anything else, e.g ':', '|' or '->'
+)
+
+type g4Token struct {
+	kind g4Kind
+	text string
+	line int
+}
+
+// g4Rule is a parser or lexer rule, with just enough detail to lint it.
+type g4Rule struct {
+	name     string
+	filename string
+	line     int
+	lexer    bool
+	fragment bool
+	mode     string
+
+	refs     map[string]bool // Rules and tokens referenced by this rule
+	literals []string        // Literals used in the rule
+	literal  string          // Set if the rule is a single literal, e.g KEYWORD: 'keyword';
+	hidden   bool            // The token never reaches the parser (skip, channel, more or type)
+
+	firsts      []string // The first element of each alternative, if it's a rule or token
+	hasBaseCase bool     // At least one alternative doesn't start with this rule
+
+	body []g4Token // The rule's alternatives, used by the Generator
+}
+
+// g4ActionUse is a named or inline action, or a semantic predicate.
+type g4ActionUse struct {
+	line int
+	name string // Name of the named action, or the rule it appears in
+}
+
+type g4File struct {
+	filename string
+	goTarget bool // Written for the Go target, so actions are expected
+	rules    []*g4Rule
+	actions  []g4ActionUse
+}
+
+// lexG4 splits g4 source into tokens, dropping whitespace and comments.
+func lexG4(src []byte) ([]g4Token, error) {
+	var tokens []g4Token
+	s := string(src)
+	line := 1
+
+	// until returns the index just past the closing delim, skipping escapes.
+	until := func(i int, close byte) (int, bool) {
+		for ; i < len(s) && s[i] != '\n'; i++ {
+			switch s[i] {
+			case '\\':
+				i++
+			case close:
+				return i + 1, true
+			}
+		}
+		return 0, false
+	}
+
+	for i := 0; i < len(s); {
+		start, startLine := i, line
+		c := s[i]
+		switch {
+		case c == '\n':
+			line++
+			i++
+			continue
+
+		case c == ' ' || c == '\t' || c == '\r' || c == '\f':
+			i++
+			continue
+
+		case strings.HasPrefix(s[i:], "//"):
+			for i < len(s) && s[i] != '\n' {
+				i++
+			}
+			continue
+
+		case strings.HasPrefix(s[i:], "/*"):
+			end := strings.Index(s[i+2:], "*/")
+			if end < 0 {
+				return nil, fmt.Errorf("line %d: unterminated comment", line)
+			}
+			line += strings.Count(s[i:i+2+end], "\n")
+			i += 2 + end + 2
+			continue
+
+		case c == '\'' || c == '[':
+			close, kind := byte('\''), g4Literal
+			if c == '[' {
+				close, kind = ']', g4Set
+			}
+			end, ok := until(i+1, close)
+			if !ok {
+				return nil, fmt.Errorf("line %d: missing %q", line, close)
+			}
+			tokens = append(tokens, g4Token{kind, s[start:end], startLine})
+			i = end
+			continue
+
+		case c == '{':
+			depth := 0
+			for ; i < len(s); i++ {
+				if s[i] == '\n' {
+					line++
+				} else if s[i] == '{' {
+					depth++
+				} else if s[i] == '}' {
+					if depth--; depth == 0 {
+						break
+					}
+				} else if s[i] == '"' || s[i] == '\'' {
+					// Skip strings in the action's language, as they may contain braces.
+					if end, ok := until(i+1, s[i]); ok {
+						i = end - 1
+					}
+				}
+			}
+			if i == len(s) {
+				return nil, fmt.Errorf("line %d: unterminated action", startLine)
+			}
+			i++
+			tokens = append(tokens, g4Token{g4Action, s[start:i], startLine})
+			continue
+
+		case c == '_' || unicode.IsLetter(rune(c)):
+			for i < len(s) && (s[i] == '_' || unicode.IsLetter(rune(s[i])) || unicode.IsDigit(rune(s[i]))) {
+				i++
+			}
+			tokens = append(tokens, g4Token{g4ID, s[start:i], startLine})
+			continue
+
+		case strings.HasPrefix(s[i:], "->"), strings.HasPrefix(s[i:], "+="), strings.HasPrefix(s[i:], "::"):
+			i += 2
+		default:
+			i++
+		}
+		tokens = append(tokens, g4Token{g4Punct, s[start:i], startLine})
+	}
+	return tokens, nil
+}
+
+// parseG4Source extracts the rules and actions from a g4 file.
+func parseG4Source(filename string, src []byte) (*g4File, error) {
+	tokens, err := lexG4(src)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %s", filename, err)
+	}
+
+	f := &g4File{
+		filename: filename,
+		goTarget: strings.HasSuffix(filename, ".GoTarget.g4"),
+	}
+
+	mode := "DEFAULT_MODE"
+	for i := 0; i < len(tokens); i++ {
+		t := tokens[i]
+		switch {
+		case t.kind == g4Punct && t.text == "@":
+			// Named action, e.g @header {...} or @lexer::members {...}
+			j := i + 1
+			for j < len(tokens) && tokens[j].kind != g4Action {
+				j++
+			}
+			if j < len(tokens) {
+				f.addAction(tokens[j], tokensText(tokens[i:j]))
+			}
+			i = j
+
+		case t.kind != g4ID:
+			// Skip anything unexpected.
+
+		case t.text == "grammar", t.text == "lexer", t.text == "parser", t.text == "import":
+			i = skipTo(tokens, i, ";")
+
+		case t.text == "mode" && i+2 < len(tokens) && tokens[i+2].text == ";":
+			mode = tokens[i+1].text
+			i += 2
+
+		case t.text == "options", t.text == "tokens", t.text == "channels":
+			i++ // Skip the block
+
+		case t.text == "catch", t.text == "finally":
+			for i < len(tokens) && tokens[i].kind != g4Action {
+				i++
+			}
+
+		default:
+			r := &g4Rule{
+				filename: filename,
+				line:     t.line,
+				mode:     mode,
+				refs:     make(map[string]bool),
+			}
+			if t.text == "fragment" && i+1 < len(tokens) {
+				r.fragment = true
+				i++
+			}
+			r.name = tokens[i].text
+			r.lexer = unicode.IsUpper(rune(r.name[0]))
+			if !r.lexer {
+				r.mode = ""
+			}
+
+			// Skip the arguments, return values, and rule actions.
+			for i++; i < len(tokens) && tokens[i].text != ":"; i++ {
+				if tokens[i].kind == g4Action && i > 0 && tokens[i-1].kind == g4ID && tokens[i-1].text != "options" {
+					f.addAction(tokens[i], r.name)
+				}
+			}
+
+			end := skipTo(tokens, i, ";")
+			if i < len(tokens) {
+				r.body = tokens[i+1 : end]
+				f.parseBody(r, r.body)
+			}
+			f.rules = append(f.rules, r)
+			i = end
+		}
+	}
+
+	return f, nil
+}
+
+// parseBody records what the rule's body references.
+func (f *g4File) parseBody(r *g4Rule, body []g4Token) {
+	var alts [][]g4Token
+	depth, start := 0, 0
+	for i, t := range body {
+		if t.kind != g4Punct {
+			continue
+		}
+		switch t.text {
+		case "(":
+			depth++
+		case ")":
+			depth--
+		case "|":
+			if depth == 0 {
+				alts = append(alts, body[start:i])
+				start = i + 1
+			}
+		}
+	}
+	alts = append(alts, body[start:])
+
+	for _, alt := range alts {
+		var elements []g4Token
+		for i := 0; i < len(alt); i++ {
+			t := alt[i]
+			switch {
+			case t.kind == g4Punct && t.text == "->":
+				f.parseCommands(r, alt[i+1:])
+				i = len(alt)
+
+			case t.kind == g4Punct && t.text == "#":
+				i++ // Skip the alternative's label
+
+			case t.kind == g4Punct && t.text == "<":
+				i = skipTo(alt, i, ">") // Skip element options, e.g <assoc=right>
+
+			case t.kind == g4ID && i+1 < len(alt) && (alt[i+1].text == "=" || alt[i+1].text == "+="):
+				i++ // Skip the label
+
+			case t.kind == g4ID:
+				r.refs[t.text] = true
+				elements = append(elements, t)
+
+			case t.kind == g4Literal:
+				r.literals = append(r.literals, t.text)
+				elements = append(elements, t)
+
+			case t.kind == g4Action:
+				if strings.TrimSpace(strings.Trim(t.text, "{}")) != "" {
+					f.addAction(t, r.name)
+				}
+
+			case t.kind == g4Set, t.kind == g4Punct && (t.text == "~" || t.text == "."):
+				elements = append(elements, t)
+			}
+		}
+
+		// The first element of the alternative, ignoring any opening parens.
+		first := ""
+		if len(elements) > 0 && elements[0].kind == g4ID {
+			first = elements[0].text
+		}
+		r.firsts = append(r.firsts, first)
+		if first != r.name {
+			r.hasBaseCase = true
+		}
+
+		if len(alts) == 1 && skipTo(alt, 0, "->") == 1 && alt[0].kind == g4Literal {
+			r.literal = alt[0].text
+		}
+	}
+}
+
+// parseCommands records the effect of lexer commands, e.g "-> skip".
+func (f *g4File) parseCommands(r *g4Rule, commands []g4Token) {
+	for i, t := range commands {
+		if t.kind != g4ID || (i > 0 && commands[i-1].text == "(") {
+			continue
+		}
+		switch t.text {
+		case "skip", "more", "channel":
+			r.hidden = true
+		case "type":
+			r.hidden = true
+			if i+2 < len(commands) {
+				r.refs[commands[i+2].text] = true
+			}
+		}
+	}
+}
+
+func (f *g4File) addAction(t g4Token, name string) {
+	if !f.goTarget {
+		f.actions = append(f.actions, g4ActionUse{line: t.line, name: name})
+	}
+}
+
+// skipTo returns the index of the next token with the given text, or
+// len(tokens) if there is none.
+func skipTo(tokens []g4Token, i int, text string) int {
+	for ; i < len(tokens); i++ {
+		if tokens[i].kind == g4Punct && tokens[i].text == text {
+			return i
+		}
+	}
+	return i
+}
+
+func tokensText(tokens []g4Token) string {
+	var text string
+	for _, t := range tokens {
+		text += t.text
+	}
+	return text
+}
+
+// lint checks the rules of all the files, which together make up one grammar.
+func lint(entryPoint string, files []*g4File) []Problem {
+	var problems []Problem
+	add := func(r *g4Rule, check, format string, args ...interface{}) {
+		problems = append(problems, Problem{
+			Filename: r.filename,
+			Line:     r.line,
+			Check:    check,
+			Msg:      fmt.Sprintf(format, args...),
+		})
+	}
+
+	var rules []*g4Rule
+	byName := make(map[string]*g4Rule)
+	hasParser := false
+	for _, f := range files {
+		for _, r := range f.rules {
+			rules = append(rules, r)
+			byName[r.name] = r
+			hasParser = hasParser || !r.lexer
+		}
+		for _, a := range f.actions {
+			problems = append(problems, Problem{
+				Filename: f.filename,
+				Line:     a.line,
+				Check:    "action",
+				Msg:      fmt.Sprintf("%s uses a target specific action", a.name),
+			})
+		}
+	}
+
+	// What is used by other rules, and by the parser.
+	used := make(map[string]bool)
+	parserRefs := make(map[string]bool)
+	parserLiterals := make(map[string]bool)
+	for _, r := range rules {
+		for ref := range r.refs {
+			if ref != r.name {
+				used[ref] = true
+			}
+			if !r.lexer {
+				parserRefs[ref] = true
+			}
+		}
+		if !r.lexer {
+			for _, literal := range r.literals {
+				parserLiterals[literal] = true
+			}
+		}
+	}
+
+	// The first rule to match each literal, keyed by mode then literal.
+	literals := make(map[[2]string]*g4Rule)
+	for _, r := range rules {
+		switch {
+		case !r.lexer && entryPoint != "" && r.name != entryPoint && !used[r.name]:
+			add(r, "unused-rule", "rule %s is never used", r.name)
+
+		case r.fragment && !used[r.name]:
+			add(r, "unused-rule", "fragment %s is never used", r.name)
+
+		case r.lexer && !r.fragment && r.literal != "" && literals[[2]string{r.mode, r.literal}] != nil:
+			add(r, "unreachable-token", "token %s is unreachable, as %s is matched first by %s", r.name, r.literal, literals[[2]string{r.mode, r.literal}].name)
+
+		case r.lexer && !r.fragment && !r.hidden && hasParser && !parserRefs[r.name] && !parserLiterals[r.literal]:
+			add(r, "unreachable-token", "token %s is never used by the parser", r.name)
+		}
+
+		if r.lexer && r.literal != "" && literals[[2]string{r.mode, r.literal}] == nil {
+			literals[[2]string{r.mode, r.literal}] = r
+		}
+
+		if !r.lexer && !r.hasBaseCase {
+			add(r, "left-recursion", "rule %s is left recursive in every alternative", r.name)
+		}
+	}
+
+	// Indirect left recursion, e.g a: b x; b: a y;
+	reported := make(map[string]bool)
+	for _, r := range rules {
+		if r.lexer || reported[r.name] {
+			continue
+		}
+		if path := leftCycle(byName, r, r.name, nil, make(map[string]bool)); path != nil {
+			for _, name := range path {
+				reported[name] = true
+			}
+			add(r, "left-recursion", "rule %s is indirectly left recursive: %s", r.name, strings.Join(append(path, r.name), " -> "))
+		}
+	}
+
+	sort.SliceStable(problems, func(i, j int) bool {
+		if problems[i].Filename != problems[j].Filename {
+			return problems[i].Filename < problems[j].Filename
+		}
+		return problems[i].Line < problems[j].Line
+	})
+	return problems
+}
+
+// leftCycle returns the path from r back to target through the first element
+// of alternatives, ignoring direct recursion which ANTLR supports.
+func leftCycle(byName map[string]*g4Rule, r *g4Rule, target string, path []string, seen map[string]bool) []string {
+	seen[r.name] = true
+	path = append(path, r.name)
+	for _, first := range r.firsts {
+		if first == r.name {
+			continue
+		}
+		if first == target {
+			return path
+		}
+		next := byName[first]
+		if next == nil || next.lexer || seen[first] {
+			continue
+		}
+		if found := leftCycle(byName, next, target, path, seen); found != nil {
+			return found
+		}
+	}
+	return nil
+}
@@ -0,0 +1,136 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package internal
+
+import (
+	"testing"
+
+	"github.com/kylelemons/godebug/pretty"
+)
+
+const lintGrammar = `grammar Lint;
+
+@header {
+import java.util.*;
+}
+
+file
+   : item* EOF
+   ;
+
+item
+   : ID '=' value ';'
+   | value { System.out.println($value.text); }
+   ;
+
+value
+   : STRING # string
+   | value '+' value # add
+   ;
+
+unused
+   : a
+   ;
+
+a
+   : b 'x'
+   ;
+
+b
+   : a 'y'
+   | 'z'
+   ;
+
+loop
+   : loop '!'
+   ;
+
+EQUALS
+   : '='
+   ;
+
+ALSO_EQUALS
+   : '='
+   ;
+
+ID
+   : LETTER (LETTER | [0-9])*
+   ;
+
+STRING
+   : '"' ~ ["]* '"'
+   ;
+
+UNUSED_TOKEN
+   : '@'
+   ;
+
+COMMENT
+   : '//' ~ [\r\n]* -> channel (HIDDEN)
+   ;
+
+WS
+   : [ \t\r\n]+ -> skip
+   ;
+
+fragment LETTER
+   : [a-zA-Z_]
+   ;
+
+fragment DIGIT
+   : [0-9]
+   ;
+`
+
+func TestLint(t *testing.T) {
+	f, err := parseG4Source("Lint.g4", []byte(lintGrammar))
+	if err != nil {
+		t.Fatalf("parseG4Source(...) err = %s, want nil", err)
+	}
+
+	var got []string
+	for _, p := range lint("file", []*g4File{f}) {
+		got = append(got, p.String())
+	}
+
+	want := []string{
+		"Lint.g4:3: @header uses a target specific action (action)",
+		"Lint.g4:13: item uses a target specific action (action)",
+		"Lint.g4:21: rule unused is never used (unused-rule)",
+		"Lint.g4:25: rule a is indirectly left recursive: a -> b -> a (left-recursion)",
+		"Lint.g4:34: rule loop is never used (unused-rule)",
+		"Lint.g4:34: rule loop is left recursive in every alternative (left-recursion)",
+		"Lint.g4:42: token ALSO_EQUALS is unreachable, as '=' is matched first by EQUALS (unreachable-token)",
+		"Lint.g4:54: token UNUSED_TOKEN is never used by the parser (unreachable-token)",
+		"Lint.g4:70: fragment DIGIT is never used (unused-rule)",
+	}
+
+	if diff := pretty.Compare(got, want); diff != "" {
+		t.Errorf("lint(...) diff: (-got +want)\n%s", diff)
+	}
+}
+
+func TestLintGoTarget(t *testing.T) {
+	f, err := parseG4Source("Lint.GoTarget.g4", []byte(lintGrammar))
+	if err != nil {
+		t.Fatalf("parseG4Source(...) err = %s, want nil", err)
+	}
+
+	for _, p := range lint("file", []*g4File{f}) {
+		if p.Check == "action" {
+			t.Errorf("lint(...) = %q, want no actions reported for the Go target", p)
+		}
+	}
+}
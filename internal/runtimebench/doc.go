@@ -0,0 +1,30 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package runtimebench benchmarks parsing the grammars' examples, to compare
+// versions of the ANTLR Go runtime before upgrading it. The benchmarks are
+// only built with the runtimebench tag, and are normally run by
+// internal/tools/runtimebench.go, which runs them once against each version
+// and reports the difference:
+//
+//	go run internal/tools/runtimebench.go 4.7.2 master
+//
+// They may also be run directly against the runtime in GOPATH:
+//
+//	go test -tags runtimebench -run NONE -bench . ./internal/runtimebench -args -grammars json,xml
+//
+// Every grammar is compiled, unless one of the grammars/all shard tags is
+// also given, such as grammars_data. With the corpus tag the examples are
+// read from the corpus package, instead of the grammars-v4 checkout.
+package runtimebench // import "bramp.net/antlr4/internal/runtimebench"
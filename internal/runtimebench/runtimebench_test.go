@@ -0,0 +1,121 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+//go:build runtimebench
+// +build runtimebench
+
+package runtimebench
+
+import (
+	"flag"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"bramp.net/antlr4/grammars"
+	_ "bramp.net/antlr4/grammars/all"
+	"bramp.net/antlr4/grammars/corpus"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+var (
+	names = flag.String("grammars", "", "comma separated names of the grammars to benchmark (default all grammars with examples)")
+	root  = flag.String("root", "../..", "root of the bramp.net/antlr4 checkout, used to find the examples")
+)
+
+// example is one of a grammar's example files.
+type example struct {
+	name string
+	data []byte
+}
+
+// examples returns the grammar's examples, from the corpus if it was
+// embedded, otherwise from the grammars-v4 checkout.
+func examples(g *grammars.Grammar) ([]example, error) {
+	var examples []example
+	if corpus.Available() {
+		for _, name := range corpus.Files(g.Name) {
+			data, err := corpus.ReadFile(g.Name, name)
+			if err != nil {
+				return nil, err
+			}
+			examples = append(examples, example{name, data})
+		}
+		return examples, nil
+	}
+
+	for _, filename := range g.Examples {
+		data, err := ioutil.ReadFile(filepath.Join(*root, filename))
+		if err != nil {
+			return nil, err
+		}
+		examples = append(examples, example{filepath.Base(filename), data})
+	}
+	return examples, nil
+}
+
+// selected returns the grammars named by the -grammars flag, or every grammar
+// with a parser.
+func selected(b *testing.B) []*grammars.Grammar {
+	if *names == "" {
+		var gs []*grammars.Grammar
+		for _, g := range grammars.All() {
+			if g.HasParser() {
+				gs = append(gs, g)
+			}
+		}
+		return gs
+	}
+
+	var gs []*grammars.Grammar
+	for _, name := range strings.Split(*names, ",") {
+		g := grammars.Lookup(name)
+		if g == nil {
+			b.Fatalf("grammar %q is not registered, is it excluded by the shard tags?", name)
+		}
+		if !g.HasParser() {
+			b.Fatalf("grammar %q does not define a parser", name)
+		}
+		gs = append(gs, g)
+	}
+	return gs
+}
+
+// BenchmarkParse parses each of the grammars' examples. Each example is a
+// sub-benchmark named <grammar>/<file>, so the results can be compared
+// between runtime versions.
+func BenchmarkParse(b *testing.B) {
+	for _, g := range selected(b) {
+		g := g
+		examples, err := examples(g)
+		if err != nil {
+			b.Fatalf("%s: %s", g.Name, err)
+		}
+
+		for _, e := range examples {
+			e := e
+			b.Run(g.Name+"/"+e.name, func(b *testing.B) {
+				input := string(e.data)
+				b.SetBytes(int64(len(e.data)))
+				b.ReportAllocs()
+				for i := 0; i < b.N; i++ {
+					if _, err := g.Parse(antlr.NewInputStream(input)); err != nil {
+						b.Fatal(err)
+					}
+				}
+			})
+		}
+	}
+}
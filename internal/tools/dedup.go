@@ -0,0 +1,134 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// dedup selects a representative subset of a grammar's examples, for
+// grammars with so many near duplicate examples that testing them all is
+// slow. The subset covers every parser rule the examples cover, and drops
+// examples whose tokens are too similar to one already chosen.
+//
+// Usage:
+//
+//	go run internal/tools/dedup.go [-min N] [-threshold F] <grammar>...
+//
+// The subset is written to <grammar>/testdata/representative.txt, which
+// make.go reads to generate the grammar's tests. They test only the subset,
+// unless the ANTLR4_ALL_EXAMPLES environment variable is set. It reads
+// grammars.json, so should be run after make.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"bramp.net/antlr4/grammars"
+	_ "bramp.net/antlr4/grammars/all"
+	"bramp.net/antlr4/grammars/coverage"
+)
+
+const (
+	INDEX_FILE          = "grammars.json"
+	REPRESENTATIVE_FILE = "testdata/representative.txt"
+
+	// SHINGLE_SIZE is the number of consecutive tokens in each shingle.
+	SHINGLE_SIZE = 5
+)
+
+var (
+	minExamples = flag.Int("min", 50, "only select a subset of grammars with more than this many examples")
+	threshold   = flag.Float64("threshold", 0.8, "examples more similar than this, from 0 to 1, to one already chosen are dropped")
+)
+
+func dedup(entry *grammars.IndexEntry) error {
+	filename := filepath.Join(entry.Name, REPRESENTATIVE_FILE)
+	if len(entry.Examples) <= *minExamples {
+		// Too few to bother, so test them all.
+		if err := os.Remove(filename); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		fmt.Printf("%s: %d examples, keeping them all\n", entry.Name, len(entry.Examples))
+		return nil
+	}
+
+	g := grammars.Lookup(entry.Name)
+	if g == nil {
+		return fmt.Errorf("grammar is not registered, run make first")
+	}
+	if !g.HasParser() {
+		return fmt.Errorf("grammar does not define a parser")
+	}
+
+	var inputs []*coverage.Input
+	for _, example := range entry.Examples {
+		input, err := grammars.NewNamedFileStream(example)
+		if err != nil {
+			return err
+		}
+		result, err := g.Parse(input)
+		if err != nil {
+			return err
+		}
+		inputs = append(inputs, &coverage.Input{
+			Name:     example,
+			Size:     input.Size(),
+			Rules:    coverage.Rules(result.Tree),
+			Shingles: coverage.NewShingles(result.Tokens.GetAllTokens(), SHINGLE_SIZE),
+		})
+	}
+
+	var lines []string
+	for _, in := range coverage.Representative(inputs, *threshold) {
+		lines = append(lines, in.Name)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(filename, []byte(strings.Join(lines, "\n")+"\n"), 0644); err != nil {
+		return err
+	}
+	fmt.Printf("%s: selected %d of %d examples\n", entry.Name, len(lines), len(entry.Examples))
+	return nil
+}
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [-min N] [-threshold F] <grammar>...\n", filepath.Base(os.Args[0]))
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	index, err := grammars.ReadIndexFile(INDEX_FILE)
+	if err != nil {
+		log.Fatalf("Failed to read %s: %s", INDEX_FILE, err)
+	}
+
+	for _, name := range flag.Args() {
+		entry := index.Lookup(name)
+		if entry == nil {
+			log.Fatalf("%s: unknown grammar", name)
+		}
+		if err := dedup(entry); err != nil {
+			log.Fatalf("%s: %s", name, err)
+		}
+	}
+}
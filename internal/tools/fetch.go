@@ -0,0 +1,256 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// fetch downloads grammars-v4 at a pinned commit, as an alternative to the
+// git submodule, for environments where cloning submodules is impractical.
+// The commit, and the SHA-256 checksum of its tarball, are pinned in
+// grammars-v4.lock, and the download is rejected if the checksum differs.
+//
+// Usage:
+//
+//	go run internal/tools/fetch.go              download the pinned commit
+//	go run internal/tools/fetch.go -pin <commit> pin a new commit
+package main
+
+import (
+	"archive/tar"
+	"bufio"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+const (
+	GRAMMARS_ROOT = "grammars-v4"
+	LOCKFILE      = "grammars-v4.lock"
+
+	// FETCHED is written into the extracted directory, recording the commit.
+	FETCHED = ".fetched"
+
+	TARBALL_URL = "https://github.com/antlr/grammars-v4/archive/%s.tar.gz"
+)
+
+var pin = flag.String("pin", "", "download this commit, and record it and its checksum in "+LOCKFILE)
+
+type lock struct {
+	Commit string
+	SHA256 string
+}
+
+func readLock(path string) (*lock, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	l := &lock{}
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+		switch fields[0] {
+		case "commit":
+			l.Commit = fields[1]
+		case "sha256":
+			l.SHA256 = fields[1]
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if l.Commit == "" || l.SHA256 == "" {
+		return nil, fmt.Errorf("%s: missing commit or sha256", path)
+	}
+	return l, nil
+}
+
+func (l *lock) write(path string) error {
+	s := fmt.Sprintf("# The grammars-v4 commit downloaded by internal/tools/fetch.go\n"+
+		"commit %s\n"+
+		"sha256 %s\n", l.Commit, l.SHA256)
+	return ioutil.WriteFile(path, []byte(s), 0644)
+}
+
+// download saves the commit's tarball to a temporary file, returning its
+// name, and SHA-256 checksum.
+func download(commit string) (string, string, error) {
+	url := fmt.Sprintf(TARBALL_URL, commit)
+	resp, err := http.Get(url)
+	if err != nil {
+		return "", "", err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", "", fmt.Errorf("failed to download %q: %s", url, resp.Status)
+	}
+
+	out, err := ioutil.TempFile("", "grammars-v4")
+	if err != nil {
+		return "", "", err
+	}
+
+	h := sha256.New()
+	if _, err := io.Copy(io.MultiWriter(out, h), resp.Body); err != nil {
+		out.Close()
+		os.Remove(out.Name())
+		return "", "", fmt.Errorf("failed to download %q: %s", url, err)
+	}
+	if err := out.Close(); err != nil {
+		os.Remove(out.Name())
+		return "", "", err
+	}
+	return out.Name(), hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// extract unpacks the tarball into dir, removing the top level directory
+// GitHub adds (e.g "grammars-v4-<commit>/").
+func extract(tarball, dir string) error {
+	f, err := os.Open(tarball)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		return err
+	}
+
+	tr := tar.NewReader(gz)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		name := hdr.Name
+		if i := strings.Index(name, "/"); i >= 0 {
+			name = name[i+1:]
+		}
+		if name == "" {
+			continue
+		}
+
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if !strings.HasPrefix(path, filepath.Clean(dir)+string(filepath.Separator)) {
+			return fmt.Errorf("tarball contains a invalid path %q", hdr.Name)
+		}
+
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			if err := os.MkdirAll(path, 0755); err != nil {
+				return err
+			}
+
+		case tar.TypeReg, tar.TypeRegA:
+			if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+				return err
+			}
+			out, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, os.FileMode(hdr.Mode)&0755|0644)
+			if err != nil {
+				return err
+			}
+			if _, err := io.Copy(out, tr); err != nil {
+				out.Close()
+				return err
+			}
+			if err := out.Close(); err != nil {
+				return err
+			}
+		}
+		// Other types, such as symlinks, aren't needed to build the grammars.
+	}
+}
+
+// isEmpty returns true if the directory doesn't exist, or is empty, as an
+// uninitialised submodule is.
+func isEmpty(dir string) bool {
+	names, err := ioutil.ReadDir(dir)
+	return os.IsNotExist(err) || (err == nil && len(names) == 0)
+}
+
+func main() {
+	flag.Parse()
+	if flag.NArg() > 0 {
+		fmt.Fprintf(os.Stderr, "Usage: %s [-pin <commit>]\n", filepath.Base(os.Args[0]))
+		os.Exit(2)
+	}
+
+	var l *lock
+	if *pin == "" {
+		var err error
+		if l, err = readLock(LOCKFILE); err != nil {
+			log.Fatalf("Failed to read lock: %s", err)
+		}
+
+		if data, err := ioutil.ReadFile(filepath.Join(GRAMMARS_ROOT, FETCHED)); err == nil && strings.TrimSpace(string(data)) == l.Commit {
+			log.Printf("%s is already at %s", GRAMMARS_ROOT, l.Commit)
+			return
+		}
+	}
+
+	// Only replace a directory this tool created, never a submodule checkout.
+	if _, err := os.Stat(filepath.Join(GRAMMARS_ROOT, FETCHED)); err != nil && !isEmpty(GRAMMARS_ROOT) {
+		log.Fatalf("%s already contains files, remove it first if it is no longer needed", GRAMMARS_ROOT)
+	}
+
+	commit := *pin
+	if l != nil {
+		commit = l.Commit
+	}
+
+	log.Printf("Downloading %s at %s", GRAMMARS_ROOT, commit)
+	tarball, sum, err := download(commit)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer os.Remove(tarball)
+
+	if l == nil {
+		l = &lock{Commit: commit, SHA256: sum}
+		if err := l.write(LOCKFILE); err != nil {
+			log.Fatalf("Failed to write lock: %s", err)
+		}
+		log.Printf("Pinned %s with sha256 %s", commit, sum)
+	} else if sum != l.SHA256 {
+		log.Fatalf("Checksum mismatch for %s: got sha256 %s, want %s", commit, sum, l.SHA256)
+	}
+
+	if err := os.RemoveAll(GRAMMARS_ROOT); err != nil {
+		log.Fatal(err)
+	}
+	if err := extract(tarball, GRAMMARS_ROOT); err != nil {
+		log.Fatalf("Failed to extract: %s", err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(GRAMMARS_ROOT, FETCHED), []byte(commit+"\n"), 0644); err != nil {
+		log.Fatal(err)
+	}
+	log.Printf("Extracted %s", GRAMMARS_ROOT)
+}
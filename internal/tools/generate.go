@@ -0,0 +1,183 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// generate synthesizes example inputs for the parser rules a grammar's
+// examples don't cover. For each uncovered rule it generates sentences from
+// the grammar aimed at the rule, and keeps the first that parses without
+// errors and covers it. The inputs are written to the grammar's
+// testdata/generated directory, which its tests parse along with the
+// examples.
+//
+// Usage:
+//
+//	go run internal/tools/generate.go [-tries N] [-seed N] <grammar>...
+//
+// It reads grammars.json, so should be run after make.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+
+	"bramp.net/antlr4/grammars"
+	_ "bramp.net/antlr4/grammars/all"
+	"bramp.net/antlr4/grammars/coverage"
+	"bramp.net/antlr4/internal"
+
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+
+const (
+	INDEX_FILE    = "grammars.json"
+	GENERATED_DIR = "testdata/generated"
+)
+
+var (
+	tries = flag.Int("tries", 100, "number of sentences to try for each uncovered rule")
+	seed  = flag.Int64("seed", 1, "seed for the sentence generator, so the output is reproducible")
+)
+
+// parse returns the rules covered by parsing the input, or an error if it
+// doesn't parse cleanly.
+func parse(g *grammars.Grammar, input antlr.CharStream) (coverage.Set, antlr.Parser, error) {
+	result, err := g.Parse(input)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(result.Errors) > 0 {
+		return nil, nil, result.Errors[0]
+	}
+	return coverage.Rules(result.Tree), result.Parser, nil
+}
+
+// generated returns the inputs previously written for the grammar.
+func generated(name string) ([]string, error) {
+	return filepath.Glob(filepath.Join(name, GENERATED_DIR, "*"))
+}
+
+func generate(entry *grammars.IndexEntry) error {
+	g := grammars.Lookup(entry.Name)
+	if g == nil {
+		return fmt.Errorf("grammar is not registered, run make first")
+	}
+	if !g.HasParser() {
+		return fmt.Errorf("grammar does not define a parser")
+	}
+
+	gen, err := internal.NewGenerator(entry.Files, *seed)
+	if err != nil {
+		return err
+	}
+
+	existing, err := generated(entry.Name)
+	if err != nil {
+		return err
+	}
+
+	// Find the rules already covered.
+	covered := make(coverage.Set)
+	var ruleNames []string
+	for _, filename := range append(entry.Examples, existing...) {
+		input, err := grammars.NewNamedFileStream(filename)
+		if err != nil {
+			return err
+		}
+		rules, parser, err := parse(g, input)
+		if err != nil {
+			log.Printf("%s: skipping %s: %s", entry.Name, filename, err)
+			continue
+		}
+		covered.Add(rules)
+		ruleNames = parser.GetRuleNames()
+	}
+	if ruleNames == nil {
+		// None of the inputs parsed, so get the names from a new parser.
+		ruleNames = g.NewParser(antlr.NewCommonTokenStream(g.NewLexer(antlr.NewInputStream("")), antlr.TokenDefaultChannel)).GetRuleNames()
+	}
+
+	ext := ".txt"
+	if len(entry.Extensions) > 0 {
+		ext = entry.Extensions[0]
+	}
+
+	dir := filepath.Join(entry.Name, GENERATED_DIR)
+	already := len(covered)
+	written, failed := 0, 0
+	for index, rule := range ruleNames {
+		if covered[index] {
+			continue
+		}
+
+		found := false
+		for i := 0; i < *tries && !found; i++ {
+			sentence, err := gen.Generate(g.EntryPoint, rule)
+			if err != nil {
+				break // The rule is unreachable, so no point trying again.
+			}
+			rules, _, err := parse(g, antlr.NewInputStream(sentence))
+			if err != nil || !rules[index] {
+				continue
+			}
+
+			if err := os.MkdirAll(dir, 0755); err != nil {
+				return err
+			}
+			filename := filepath.Join(dir, rule+ext)
+			if err := ioutil.WriteFile(filename, []byte(sentence+"\n"), 0644); err != nil {
+				return err
+			}
+			// The sentence may cover other uncovered rules as well.
+			covered.Add(rules)
+			found = true
+			written++
+		}
+		if !found {
+			failed++
+		}
+	}
+
+	fmt.Printf("%s: %d of %d rules already covered, wrote %d inputs, failed to cover %d rules\n", entry.Name, already, len(ruleNames), written, failed)
+	return nil
+}
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [-tries N] [-seed N] <grammar>...\n", filepath.Base(os.Args[0]))
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	index, err := grammars.ReadIndexFile(INDEX_FILE)
+	if err != nil {
+		log.Fatalf("Failed to read %s: %s", INDEX_FILE, err)
+	}
+
+	for _, name := range flag.Args() {
+		entry := index.Lookup(name)
+		if entry == nil {
+			log.Fatalf("%s: unknown grammar", name)
+		}
+		if err := generate(entry); err != nil {
+			log.Fatalf("%s: %s", name, err)
+		}
+	}
+}
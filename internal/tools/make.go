@@ -12,21 +12,118 @@
 // See the License for the specific language governing permissions and
 // limitations under the License.
 
-// make creates the test and example files for the given grammar.
+// make creates the test, example and registration files for the given grammar.
 package main
 
 import (
+	"bramp.net/antlr4/grammars"
 	"bramp.net/antlr4/internal"
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
 	"fmt"
 	"github.com/iancoleman/strcase"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
 	"log"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
 	"strings"
 	"text/template"
 )
 
+// ANTLR_VERSION is the version of ANTLR the Makefile generates the grammars with.
+const ANTLR_VERSION = internal.ANTLRVersion
+
+// ANTLR_JAR is where the go:generate directives in each generated package
+// expect the ANTLR_VERSION jar, the same place Maven, and the Makefile, put it.
+const ANTLR_JAR = "$HOME/.m2/repository/org/antlr/antlr4/" + ANTLR_VERSION + "/antlr4-" + ANTLR_VERSION + "-complete.jar"
+
+// CORPUS_FILES is the maximum number of examples per grammar copied into the
+// embedded corpus, smallest first, skipping any larger than CORPUS_MAX_SIZE.
+const CORPUS_FILES = 3
+const CORPUS_MAX_SIZE = 16 << 10
+
+// ENTRYPOINTS_FILE overrides the entry point declared in a grammar's pom.xml.
+const ENTRYPOINTS_FILE = "internal/tools/entrypoints.txt"
+
+// UNICODE_FILE lists inputs with characters outside the Basic Multilingual
+// Plane, which the generated tests check are lexed and parsed correctly.
+const UNICODE_FILE = "internal/tools/unicode.txt"
+
+// REPRESENTATIVE_FILE, within a grammar's directory, lists the subset of its
+// examples its tests use, as selected by dedup.go.
+const REPRESENTATIVE_FILE = "testdata/representative.txt"
+
+// SHARDS_FILE assigns grammars to the shards of grammars/all, any not listed
+// are in the MISC_SHARD.
+const SHARDS_FILE = "internal/tools/shards.txt"
+const MISC_SHARD = "misc"
+
+// TEST_PASSED and TEST_FLAKY are the outcomes of a grammar's tests, recorded
+// by "make.go tier". Flaky tests failed, but then passed when retried.
+const (
+	TEST_PASSED = "passed"
+	TEST_FLAKY  = "flaky"
+)
+
+// tierRe matches the Tier in a generated register.go.
+var tierRe = regexp.MustCompile(`(?m)^(\s*Tier:\s*)grammars\.(\w+),$`)
+
+// tierOf returns the tier of a grammar with the given test outcome.
+func tierOf(outcome string, hasExamples bool) grammars.Tier {
+	switch {
+	case outcome == TEST_FLAKY:
+		return grammars.Flaky
+	case !hasExamples:
+		return grammars.Untested
+	}
+	return grammars.Stable
+}
+
+// readTier returns the tier recorded in the grammar's register.go.
+func readTier(dir string) (grammars.Tier, error) {
+	src, err := ioutil.ReadFile(filepath.Join(dir, "register.go"))
+	if err != nil {
+		return 0, err
+	}
+	m := tierRe.FindSubmatch(src)
+	if m == nil {
+		return 0, fmt.Errorf("%s: no Tier found in register.go", dir)
+	}
+	var tier grammars.Tier
+	err = tier.UnmarshalText(bytes.ToLower(m[2]))
+	return tier, err
+}
+
+// writeTier records the tier of the grammar in dir, from the outcome of its
+// tests, in its register.go.
+func writeTier(dir, outcome string) error {
+	if outcome != TEST_PASSED && outcome != TEST_FLAKY {
+		return fmt.Errorf("unknown test outcome %q, want %q or %q", outcome, TEST_PASSED, TEST_FLAKY)
+	}
+
+	filename := filepath.Join(dir, "register.go")
+	src, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	if !tierRe.Match(src) {
+		return fmt.Errorf("%s: no Tier found in register.go", dir)
+	}
+
+	tier := tierOf(outcome, bytes.Contains(src, []byte("Examples: []string{")))
+	src = tierRe.ReplaceAll(src, []byte("${1}grammars."+strings.Title(tier.String())+","))
+	return ioutil.WriteFile(filename, src, 0644)
+}
+
 const COPYRIGHT = `// Copyright 2017 Google Inc.
 //
 // Licensed under the Apache License, Version 2.0 (the "License");
@@ -61,13 +158,16 @@ package {{ .PackageName }}_test
 
 import (
 	"bramp.net/antlr4/{{ .PackageName }}"
-{{ if .Project.HasParser -}}
+{{ if or .Project.HasParser .UnicodeInputs -}}
 	"bramp.net/antlr4/internal"
 {{- end }}
 
 {{ if .Project.HasParser }}
 	"fmt"
 {{ end -}}
+{{ if .Representative -}}
+	"os"
+{{ end -}}
 {{ if or (eq .Project.CaseInsensitiveType "UPPER") (eq .Project.CaseInsensitiveType "lower") -}}
 	"strings"
 {{ end -}}
@@ -78,11 +178,45 @@ import (
 
 const MAX_TOKENS = 1000000
 
+{{- if .Representative }}
+
+// examples are a representative subset of allExamples, selected by
+// internal/tools/dedup.go, which cover the same rules. Set ANTLR4_ALL_EXAMPLES
+// to test them all.
+var examples = []string{
+{{- range $_, $example := .Representative }}
+	{{ printf "%q" . }},
+{{- end }}
+}
+
+var allExamples = []string{
+{{- range $_, $example := .Project.Examples }}
+	{{ printf "%q" . }},
+{{- end }}
+}
+
+func init() {
+	if os.Getenv("ANTLR4_ALL_EXAMPLES") != "" {
+		examples = allExamples
+	}
+}
+{{- else }}
+
 var examples = []string{
 {{- range $_, $example := .Project.Examples }}
 	{{ printf "%q" . }},
 {{- end }}
 }
+{{- end }}
+{{- if .UnicodeInputs }}
+
+// unicodeInputs contain characters outside the Basic Multilingual Plane.
+var unicodeInputs = []string{
+{{- range $_, $input := .UnicodeInputs }}
+	{{ printf "%+q" . }},
+{{- end }}
+}
+{{- end }}
 
 {{ if .Project.HasParser }}
 type exampleListener struct {
@@ -130,6 +264,17 @@ func Example() {
 {{ end -}}
 }
 
+// generated returns the inputs written by internal/tools/generate.go to cover
+// the rules the examples don't, relative to the root of this repository like
+// the examples.
+func generated() []string {
+	files, _ := filepath.Glob(filepath.Join("testdata", "generated", "*"))
+	for i, file := range files {
+		files[i] = filepath.Join("{{ .PackageName }}", file)
+	}
+	return files
+}
+
 func newCharStream(filename string) (antlr.CharStream, error) {
 	var input antlr.CharStream
 	input, err := antlr.NewFileStream(filepath.Join("..", filename))
@@ -147,7 +292,7 @@ func newCharStream(filename string) (antlr.CharStream, error) {
 }
 
 func Test{{ .Project.LexerName | Title }}(t *testing.T) {
-	for _, file := range examples {
+	for _, file := range append(examples, generated()...) {
 		input, err := newCharStream(file)
 		if err != nil {
 			t.Errorf("Failed to open example file: %s", err)
@@ -172,11 +317,53 @@ func Test{{ .Project.LexerName | Title }}(t *testing.T) {
 	}
 }
 
+{{ if .UnicodeInputs }}
+func Test{{ .Project.LexerName | Title }}Unicode(t *testing.T) {
+	for _, s := range unicodeInputs {
+		runes := []rune(s)
+		var input antlr.CharStream = antlr.NewInputStream(s)
+		{{- if eq .Project.CaseInsensitiveType "UPPER" }}
+		input = internal.NewCaseChangingStream(input, true)
+		{{- else if eq .Project.CaseInsensitiveType "lower" }}
+		input = internal.NewCaseChangingStream(input, false)
+		{{- end }}
+
+		lexer := {{ .PackageName }}.New{{ .Project.LexerName }}(input)
+		lexer.RemoveErrorListeners()
+		lexer.AddErrorListener(internal.NewTestingErrorListener(t, s))
+{{ if .Project.HasParser }}
+		stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+
+		p := {{ .PackageName }}.New{{ .Project.ParserName }}(stream)
+		p.RemoveErrorListeners()
+		p.AddErrorListener(internal.NewTestingErrorListener(t, s))
+		p.{{ .Project.EntryPoint | Title }}()
+
+		for _, tok := range stream.GetAllTokens() {
+{{- else }}
+		for tok := lexer.NextToken(); tok.GetTokenType() != antlr.TokenEOF; tok = lexer.NextToken() {
+		{{- end }}
+			// Token positions are indexes of code points, not bytes or UTF-16 units.
+			start, stop := tok.GetStart(), tok.GetStop()
+			if tok.GetTokenType() == antlr.TokenEOF || stop < start {
+				continue
+			}
+			if stop >= len(runes) {
+				t.Errorf("New{{ .Project.LexerName }}(%+q) token %+q stops at %d, beyond the end of the input", s, tok.GetText(), stop)
+				continue
+			}
+			if want := string(runes[start : stop+1]); tok.GetText() != want {
+				t.Errorf("New{{ .Project.LexerName }}(%+q) token %+q, want %+q", s, tok.GetText(), want)
+			}
+		}
+	}
+}
+{{ end }}
 {{ if .Project.HasParser }}
 func Test{{ .Project.ParserName | Title }}(t *testing.T) {
 	// TODO(bramp): Run this test with and without p.BuildParseTrees
 
-	for _, file := range examples {
+	for _, file := range append(examples, generated()...) {
 		input, err := newCharStream(file)
 		if err != nil {
 			t.Errorf("Failed to open example file: %s", err)
@@ -201,9 +388,293 @@ func Test{{ .Project.ParserName | Title }}(t *testing.T) {
 {{ end }}
 `
 
+// REGISTERFILE is the template for a file which registers this grammar with
+// the grammars package. It expects to be executed with a pom.
+const REGISTERFILE = `{{template "copyright" .}}
+// Do not edit this file, it is generated by make.go
+//
+
+package {{ .PackageName }}
+
+import (
+	"bramp.net/antlr4/grammars"
+	"github.com/antlr/antlr4/runtime/Go/antlr"
+)
+{{- if .Generate }}
+
+// This package was generated by ANTLR {{ .ANTLRVersion }} from:
+//
+{{- range $_, $g := .Generate }}
+//	{{ $g.Path }} (sha256 {{ $g.SHA256 }})
+{{- end }}
+//
+// To regenerate it in place, for example after vendoring it, copy the grammar
+// files into this directory, and run "go generate". The hashes show if the
+// grammar has changed since.
+{{- range $_, $g := .Generate }}
+//go:generate java -jar {{ $.ANTLRJar }} -Dlanguage=Go -listener -no-visitor -package {{ $.PackageName }} -Xexact-output-dir -o . {{ $g.Filename }}
+{{- end }}
+{{- end }}
+
+func init() {
+	grammars.Register(&grammars.Grammar{
+		Name:     {{ printf "%q" .PackageName }},
+		LongName: {{ printf "%q" .Project.LongName }},
+
+		NewLexer: func(input antlr.CharStream) antlr.Lexer {
+			return New{{ .Project.LexerName }}(input)
+		},
+{{- if .Project.HasParser }}
+
+		NewParser: func(input antlr.TokenStream) antlr.Parser {
+			return New{{ .Project.ParserName }}(input)
+		},
+
+		EntryPoint: {{ printf "%q" .Project.EntryPoint }},
+		Start: func(parser antlr.Parser) antlr.ParserRuleContext {
+			return parser.(*{{ .Project.ParserName }}).{{ .Project.EntryPoint | Title }}()
+		},
+{{- end }}
+{{ if .Project.HasParser }}
+		RuleIndex: RuleIndex,
+{{- end }}
+		TokenType: TokenType,
+{{- if .Project.CaseInsensitiveType }}
+
+		CaseInsensitiveType: {{ printf "%q" .Project.CaseInsensitiveType }},
+{{- end }}
+{{- if .Project.Examples }}
+
+		Examples: []string{
+{{- range $_, $example := .Project.Examples }}
+			{{ printf "%q" $example }},
+{{- end }}
+		},
+{{- end }}
+
+		Tier: grammars.{{ .Tier.String | Title }},
+{{- if .Flags }}
+
+		Flags: map[string]*bool{
+{{- range $_, $flag := .Flags }}
+			{{ printf "%q" $flag }}: &{{ $flag }},
+{{- end }}
+		},
+{{- end }}
+{{- if .Licenses }}
+
+		Licenses: []grammars.License{
+{{- range $_, $license := .Licenses }}
+			{
+				Filename: {{ printf "%q" $license.Filename }},
+				SPDX:     {{ printf "%q" $license.SPDX }},
+				Text:     {{ printf "%q" $license.Text }},
+			},
+{{- end }}
+		},
+{{- end }}
+	})
+}
+{{ if .Project.HasParser }}
+var ruleIndexes = grammars.NameIndexes(ruleNames)
+
+// RuleIndex returns the index of the parser rule with the given name, such as
+// {{ .Project.ParserName }}RULE_{{ .Project.EntryPoint }} for {{ printf "%q" .Project.EntryPoint }}, or false if there is none.
+func RuleIndex(name string) (int, bool) {
+	i, ok := ruleIndexes[name]
+	return i, ok
+}
+
+var tokenTypes = grammars.NameIndexes(symbolicNames, literalNames)
+{{ else }}
+var tokenTypes = grammars.NameIndexes(lexerSymbolicNames, lexerLiteralNames)
+{{ end }}
+// TokenType returns the type of the token with the given symbolic name, e.g
+// "ID", or literal name including its quotes, e.g "'='", or false if there is
+// none.
+func TokenType(name string) (int, bool) {
+	t, ok := tokenTypes[name]
+	return t, ok
+}
+`
+
+// AGGREGATORFILE is the template for a generic visitor of this grammar's
+// parse trees. It expects to be executed with the Aggregator and its
+// Contexts.
+const AGGREGATORFILE = `{{template "copyright" .}}
+//go:build go1.18
+// +build go1.18
+
+// Do not edit this file, it is generated by make.go
+//
+
+package {{ .PackageName }}
+
+import "github.com/antlr/antlr4/runtime/Go/antlr"
+
+// {{ .Aggregator }} visits a parse tree, combining the results of each node's
+// children, so an analysis only needs to set the Visit functions of the nodes
+// it is interested in. For example to count the tokens in a tree:
+//
+//	a := New{{ .Aggregator }}(0, func(aggregate, next int) int {
+//		return aggregate + next
+//	})
+//	a.VisitTerminal = func(node antlr.TerminalNode) int {
+//		return 1
+//	}
+//	count := a.Visit(tree)
+type {{ .Aggregator }}[T any] struct {
+	// Default is the result of a node without children.
+	Default T
+
+	// Combine returns the result so far combined with the next child's.
+	Combine func(aggregate, next T) T
+
+	// VisitTerminal, if not nil, returns the result of a token or error node,
+	// instead of Default.
+	VisitTerminal func(node antlr.TerminalNode) T
+{{ range $_, $c := .Contexts }}
+	// {{ $c.Field }}, if not nil, returns the result of a {{ $c.Context }}, instead of
+	// combining its children. It may call VisitChildren to do that too.
+	{{ $c.Field }} func(ctx *{{ $c.Context }}) T
+{{ end -}}
+}
+
+// New{{ .Aggregator }} returns a {{ .Aggregator }} combining results with
+// combine, starting from defaultResult.
+func New{{ .Aggregator }}[T any](defaultResult T, combine func(aggregate, next T) T) *{{ .Aggregator }}[T] {
+	return &{{ .Aggregator }}[T]{
+		Default: defaultResult,
+		Combine: combine,
+	}
+}
+
+// Visit returns the result of the tree.
+func (a *{{ .Aggregator }}[T]) Visit(tree antlr.Tree) T {
+	switch t := tree.(type) {
+	case antlr.TerminalNode:
+		if a.VisitTerminal != nil {
+			return a.VisitTerminal(t)
+		}
+		return a.Default
+{{- range $_, $c := .Contexts }}
+	case *{{ $c.Context }}:
+		if a.{{ $c.Field }} != nil {
+			return a.{{ $c.Field }}(t)
+		}
+{{- end }}
+	}
+	return a.VisitChildren(tree)
+}
+
+// VisitChildren returns the Default combined with the result of each of the
+// tree's children in turn.
+func (a *{{ .Aggregator }}[T]) VisitChildren(tree antlr.Tree) T {
+	result := a.Default
+	for _, child := range tree.GetChildren() {
+		result = a.Combine(result, a.Visit(child))
+	}
+	return result
+}
+`
+
+// ALLFILE is the template for a package that imports every grammar.
+// It expects to be executed with a list of Packages.
+const ALLFILE = `{{template "copyright" .}}
+// Package all imports every grammar, so they can all be found with
+// grammars.Lookup.
+//
+// The grammars are split into shards, so programs can compile only the
+// grammars they need, by building with the shards' tags:
+//
+{{- range $_, $shard := .Shards }}
+//	grammars_{{ $shard }}
+{{- end }}
+//
+// Without any of the tags, or with grammars_all, every shard is imported.
+//
+// Do not edit this file, it is generated by make.go
+//
+package all // import "bramp.net/antlr4/grammars/all"
+`
+
+// SHARDFILE is the template for one shard of the all package, importing its
+// grammars when built with the shard's tag. It expects to be executed with
+// the Shard, all the Shards, and the shard's Packages.
+const SHARDFILE = `{{template "copyright" .}}
+//go:build grammars_all || grammars_{{ .Shard }} || !({{ range $i, $shard := .Shards }}{{ if $i }} || {{ end }}grammars_{{ $shard }}{{ end }})
+// +build grammars_all grammars_{{ .Shard }} {{ range $i, $shard := .Shards }}{{ if $i }},{{ end }}!grammars_{{ $shard }}{{ end }}
+
+// Do not edit this file, it is generated by make.go
+//
+
+package all
+
+import (
+{{- range $_, $pkg := .Packages }}
+	_ "bramp.net/antlr4/{{ $pkg }}"
+{{- end }}
+)
+`
+
 type templateData struct {
 	PackageName string
 	Project     *internal.Project
+	Tier        grammars.Tier
+	Flags       []string
+	Licenses    []grammars.License
+
+	UnicodeInputs  []string
+	Representative []string
+
+	Packages []string
+	Shard    string
+	Shards   []string
+
+	ANTLRVersion string
+	ANTLRJar     string
+	Generate     []generateDirective
+
+	Aggregator string
+	Contexts   []aggregatorContext
+}
+
+// aggregatorContext is a parser rule (or labelled alternative) context, which
+// has a Visit function in the Aggregator.
+type aggregatorContext struct {
+	Field   string // e.g "VisitJson"
+	Context string // e.g "JsonContext"
+}
+
+// generateDirective is a g4 file the package is generated from, which
+// becomes a go:generate directive in its register.go.
+type generateDirective struct {
+	Path     string // e.g "grammars-v4/json/JSON.g4"
+	Filename string // e.g "JSON.g4"
+	SHA256   string
+}
+
+// generateDirectives returns the directives to regenerate the project's
+// grammars, lexers first, as a parser grammar needs its lexer's tokens.
+func generateDirectives(project *internal.Project) ([]generateDirective, error) {
+	var directives []generateDirective
+	for _, lexers := range []bool{true, false} {
+		for _, g := range project.Grammars {
+			if (g.Type == internal.LEXER) != lexers {
+				continue
+			}
+			data, err := ioutil.ReadFile(g.Filename)
+			if err != nil {
+				return nil, err
+			}
+			directives = append(directives, generateDirective{
+				Path:     g.Filename,
+				Filename: filepath.Base(g.Filename),
+				SHA256:   fmt.Sprintf("%x", sha256.Sum256(data)),
+			})
+		}
+	}
+	return directives, nil
 }
 
 func create(filename string, t *template.Template, data *templateData) error {
@@ -227,11 +698,550 @@ func create(filename string, t *template.Template, data *templateData) error {
 	return nil
 }
 
+// readMakefile returns the files (the pom.xml and g4s) each grammar is built
+// from, by reading the test rules makemake.go generated.
+func readMakefile(path string) (map[string][]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	files := make(map[string][]string)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		// e.g "	${TEST} json grammars-v4/json/pom.xml grammars-v4/json/JSON.g4"
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 3 || fields[0] != "${TEST}" {
+			continue
+		}
+		files[fields[1]] = fields[2:]
+	}
+	return files, scanner.Err()
+}
+
+// grammarsV4Commit returns the commit of grammars-v4 that is checked out, or
+// was fetched.
+func grammarsV4Commit() string {
+	if data, err := ioutil.ReadFile(filepath.Join("grammars-v4", ".fetched")); err == nil {
+		return strings.TrimSpace(string(data))
+	}
+
+	// e.g " 1d2e9f3... grammars-v4 (heads/master)", with a leading "-" if
+	// not initialised, or "+" if it differs from the commit in the index.
+	out, err := exec.Command("git", "submodule", "status", "grammars-v4").Output()
+	if err != nil {
+		log.Printf("Failed to find the grammars-v4 commit: %s", err)
+		return ""
+	}
+	fields := strings.Fields(string(out))
+	if len(fields) == 0 {
+		return ""
+	}
+	return strings.TrimLeft(fields[0], "-+U")
+}
+
+// buildIndex returns the index of every grammar in the Makefile, noting which
+// ones passed.
+func buildIndex(passed map[string]bool) (*grammars.Index, error) {
+	files, err := readMakefile("Makefile")
+	if err != nil {
+		return nil, err
+	}
+
+	entryPoints, err := readEntryPoints(ENTRYPOINTS_FILE)
+	if err != nil {
+		return nil, err
+	}
+
+	idx := &grammars.Index{
+		ANTLRVersion: ANTLR_VERSION,
+		GrammarsV4:   grammarsV4Commit(),
+	}
+	for name, f := range files {
+		e := &grammars.IndexEntry{
+			Name:       name,
+			ImportPath: "bramp.net/antlr4/" + name,
+			Pom:        f[0],
+			Files:      f[1:],
+			Passed:     passed[name],
+		}
+
+		project, err := internal.ParsePom(e.Pom)
+		if err != nil {
+			log.Printf("Failed to read pom file %q: %s", e.Pom, err)
+		} else {
+			e.LongName = project.LongName
+			e.EntryPoint = project.EntryPoint
+			if entryPoint, ok := entryPoints[name]; ok {
+				e.EntryPoint = entryPoint
+			}
+			e.CaseInsensitiveType = project.CaseInsensitiveType
+			e.Examples = project.Examples
+
+			exts := make(map[string]bool)
+			for _, example := range project.Examples {
+				if ext := strings.ToLower(filepath.Ext(example)); ext != "" && !exts[ext] {
+					exts[ext] = true
+					e.Extensions = append(e.Extensions, ext)
+				}
+			}
+			sort.Strings(e.Extensions)
+		}
+
+		if e.Passed {
+			idx.PassRate++
+
+			tier, err := readTier(name)
+			if err != nil {
+				return nil, err
+			}
+			e.Tier = &tier
+		}
+		idx.Grammars = append(idx.Grammars, e)
+	}
+
+	if len(idx.Grammars) > 0 {
+		idx.PassRate /= float64(len(idx.Grammars))
+	}
+	sort.Slice(idx.Grammars, func(i, j int) bool {
+		return idx.Grammars[i].Name < idx.Grammars[j].Name
+	})
+	return idx, nil
+}
+
+func writeIndex(filename string, idx *grammars.Index) error {
+	data, err := json.MarshalIndent(idx, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filename, append(data, '\n'), 0644)
+}
+
+// readEntryPoints reads the entry point overrides, keyed by grammar name. Each
+// line is a grammar name followed by the rule to use, blank lines and lines
+// starting with # are ignored.
+func readEntryPoints(filename string) (map[string]string, error) {
+	f, err := os.Open(filename)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	entryPoints := make(map[string]string)
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+			continue
+		}
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("%s:%d: want \"<grammar> <entry point>\", got %q", filename, line, scanner.Text())
+		}
+		entryPoints[fields[0]] = fields[1]
+	}
+	return entryPoints, scanner.Err()
+}
+
+// readRepresentative returns the examples listed in filename, or nil if it
+// doesn't exist. Any no longer in examples are ignored, and if none remain it
+// returns nil, so all the examples are tested.
+func readRepresentative(filename string, examples []string) ([]string, error) {
+	data, err := ioutil.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	known := make(map[string]bool)
+	for _, example := range examples {
+		known[example] = true
+	}
+
+	var representative []string
+	for _, line := range strings.Split(string(data), "\n") {
+		if line = strings.TrimSpace(line); known[line] {
+			representative = append(representative, line)
+		}
+	}
+	return representative, nil
+}
+
+// readShards reads the shard of each grammar, returning a function to look
+// up a grammar's shard, and the names of all the shards, in the order they
+// are first listed, followed by the MISC_SHARD.
+func readShards(filename string) (func(string) string, []string, error) {
+	f, err := os.Open(filename)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, nil, err
+	}
+
+	shardOf := make(map[string]string)
+	known := make(map[string]bool)
+	var shards []string
+	if err == nil {
+		defer f.Close()
+
+		scanner := bufio.NewScanner(f)
+		for line := 1; scanner.Scan(); line++ {
+			fields := strings.Fields(scanner.Text())
+			if len(fields) == 0 || strings.HasPrefix(fields[0], "#") {
+				continue
+			}
+			if len(fields) < 2 {
+				return nil, nil, fmt.Errorf("%s:%d: want \"<shard> <grammar>...\", got %q", filename, line, scanner.Text())
+			}
+			shard := fields[0]
+			if shard == MISC_SHARD || shard == "all" {
+				return nil, nil, fmt.Errorf("%s:%d: shard %q is reserved", filename, line, shard)
+			}
+			if !known[shard] {
+				known[shard] = true
+				shards = append(shards, shard)
+			}
+			for _, name := range fields[1:] {
+				if prev, found := shardOf[name]; found && prev != shard {
+					return nil, nil, fmt.Errorf("%s:%d: %s is in both the %s and %s shards", filename, line, name, prev, shard)
+				}
+				shardOf[name] = shard
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	lookup := func(name string) string {
+		if shard, found := shardOf[name]; found {
+			return shard
+		}
+		return MISC_SHARD
+	}
+	return lookup, append(shards, MISC_SHARD), nil
+}
+
+// readUnicodeInputs reads the inputs with supplementary plane characters,
+// keyed by grammar name. Each line is a grammar name followed by a Go quoted
+// string, blank lines and lines starting with # are ignored.
+func readUnicodeInputs(filename string) (map[string][]string, error) {
+	f, err := os.Open(filename)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	inputs := make(map[string][]string)
+	scanner := bufio.NewScanner(f)
+	for line := 1; scanner.Scan(); line++ {
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		fields := strings.SplitN(text, " ", 2)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("%s:%d: want \"<grammar> <quoted input>\", got %q", filename, line, text)
+		}
+		input, err := strconv.Unquote(strings.TrimSpace(fields[1]))
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: input is not a quoted string: %s", filename, line, err)
+		}
+		inputs[fields[0]] = append(inputs[fields[0]], input)
+	}
+	return inputs, scanner.Err()
+}
+
+// ruleMethods returns the methods of the generated parser in dir that take no
+// arguments, and so may be called by the tests as the entry point.
+func ruleMethods(dir, parserName string) ([]string, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*_parser.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	var methods []string
+	fset := token.NewFileSet()
+	for _, filename := range files {
+		f, err := parser.ParseFile(fset, filename, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || len(fn.Recv.List) != 1 || fn.Type.Params.NumFields() != 0 {
+				continue
+			}
+			star, ok := fn.Recv.List[0].Type.(*ast.StarExpr)
+			if !ok {
+				continue
+			}
+			if recv, ok := star.X.(*ast.Ident); ok && recv.Name == parserName && fn.Name.IsExported() {
+				methods = append(methods, fn.Name.Name)
+			}
+		}
+	}
+	sort.Strings(methods)
+	return methods, nil
+}
+
+// aggregatorContexts returns the name of the Aggregator for the generated
+// parser in dir, and the contexts it visits, found from the Enter methods of
+// the generated listener.
+func aggregatorContexts(dir string) (string, []aggregatorContext, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*_listener.go"))
+	if err != nil {
+		return "", nil, err
+	}
+
+	var name string
+	var contexts []aggregatorContext
+	fset := token.NewFileSet()
+	for _, filename := range files {
+		f, err := parser.ParseFile(fset, filename, nil, 0)
+		if err != nil {
+			return "", nil, err
+		}
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.TYPE {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				ts := spec.(*ast.TypeSpec)
+				iface, ok := ts.Type.(*ast.InterfaceType)
+				if !ok || !strings.HasSuffix(ts.Name.Name, "Listener") {
+					continue
+				}
+				if name != "" {
+					return "", nil, fmt.Errorf("found listeners %s and %s, want one", name, ts.Name.Name)
+				}
+				name = strings.TrimSuffix(ts.Name.Name, "Listener") + "Aggregator"
+
+				for _, method := range iface.Methods.List {
+					if len(method.Names) != 1 || !strings.HasPrefix(method.Names[0].Name, "Enter") {
+						continue
+					}
+					params := method.Type.(*ast.FuncType).Params.List
+					if len(params) != 1 {
+						continue
+					}
+					star, ok := params[0].Type.(*ast.StarExpr)
+					if !ok {
+						continue
+					}
+					ctx, ok := star.X.(*ast.Ident)
+					if !ok {
+						continue
+					}
+
+					field := "Visit" + strings.TrimPrefix(method.Names[0].Name, "Enter")
+					switch field {
+					case "Visit", "VisitTerminal", "VisitChildren":
+						// Avoid the Aggregator's own fields and methods.
+						field += "Context"
+					}
+					contexts = append(contexts, aggregatorContext{
+						Field:   field,
+						Context: ctx.Name,
+					})
+				}
+			}
+		}
+	}
+	if name == "" {
+		return "", nil, fmt.Errorf("no listener found in %q", dir)
+	}
+	return name, contexts, nil
+}
+
+// flagVars returns the package level bool variables declared by the
+// generated lexer and parser in dir, which are the flags the grammar's
+// semantic predicates read, e.g ecmascript's strictMode.
+func flagVars(dir string) ([]string, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+
+	var flags []string
+	fset := token.NewFileSet()
+	for _, filename := range files {
+		if !strings.HasSuffix(filename, "_lexer.go") && !strings.HasSuffix(filename, "_parser.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, filename, nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.VAR {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				vs := spec.(*ast.ValueSpec)
+				if typ, ok := vs.Type.(*ast.Ident); !ok || typ.Name != "bool" {
+					continue
+				}
+				for _, name := range vs.Names {
+					flags = append(flags, name.Name)
+				}
+			}
+		}
+	}
+	sort.Strings(flags)
+	return flags, nil
+}
+
+// validateEntryPoint returns an error if the project's entry point isn't a
+// rule of the generated parser in dir, which the tests can call.
+func validateEntryPoint(dir string, project *internal.Project) error {
+	if !project.HasParser() {
+		return nil
+	}
+	if project.EntryPoint == "" {
+		return fmt.Errorf("%s declares no entry point, add one to %s", project.FileName, ENTRYPOINTS_FILE)
+	}
+
+	methods, err := ruleMethods(dir, project.ParserName())
+	if err != nil {
+		return err
+	}
+	want := strings.Title(project.EntryPoint)
+	for _, method := range methods {
+		if method == want {
+			return nil
+		}
+	}
+	return fmt.Errorf("entry point %q is not a rule of %s (no method %s()), override it in %s, the rules are: %s",
+		project.EntryPoint, project.ParserName(), want, ENTRYPOINTS_FILE, strings.Join(methods, ", "))
+}
+
+// checkGenerated returns an error if the Go files ANTLR generated in dir are
+// not those expected by project.GeneratedFilenames, which is used to write the
+// Makefile. This catches changes to ANTLR's naming scheme, which would
+// otherwise leave packages silently incomplete.
+func checkGenerated(dir string, project *internal.Project) error {
+	expected := make(map[string]bool)
+	for _, name := range project.GeneratedFilenames() {
+		expected[name] = true
+	}
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return err
+	}
+
+	var unexpected []string
+	found := make(map[string]bool)
+	for _, file := range files {
+		name := filepath.Base(file)
+		switch {
+		case expected[name]:
+			found[name] = true
+		case name == "register.go", name == "aggregator.go", name == "doc.go", name == dir+"_test.go":
+			// Created by make.go
+		default:
+			unexpected = append(unexpected, name)
+		}
+	}
+
+	var missing []string
+	for _, name := range project.GeneratedFilenames() {
+		if !found[name] {
+			missing = append(missing, name)
+		}
+	}
+
+	if len(unexpected) > 0 || len(missing) > 0 {
+		return fmt.Errorf("ANTLR generated unexpected files %q, and not the expected files %q, update GeneratedFilenames in internal/pom.go to match",
+			unexpected, missing)
+	}
+	return nil
+}
+
+// writeCorpus replaces the grammars in dir with a copy of the smallest
+// examples of each grammar in the index that passed its tests.
+func writeCorpus(dir string) error {
+	idx, err := grammars.ReadIndexFile(grammars.IndexFilename)
+	if err != nil {
+		return err
+	}
+
+	// Remove the old corpus, but not the README
+	old, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, info := range old {
+		if info.IsDir() {
+			if err := os.RemoveAll(filepath.Join(dir, info.Name())); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, e := range idx.Grammars {
+		if !e.Passed {
+			continue
+		}
+
+		var examples []os.FileInfo
+		paths := make(map[os.FileInfo]string)
+		for _, example := range e.Examples {
+			info, err := os.Stat(example)
+			if err != nil {
+				return err
+			}
+			if info.Mode().IsRegular() && info.Size() > 0 && info.Size() <= CORPUS_MAX_SIZE {
+				examples = append(examples, info)
+				paths[info] = example
+			}
+		}
+		sort.SliceStable(examples, func(i, j int) bool {
+			return examples[i].Size() < examples[j].Size()
+		})
+
+		used := make(map[string]bool)
+		for _, info := range examples {
+			if len(used) == CORPUS_FILES {
+				break
+			}
+			if used[info.Name()] {
+				continue
+			}
+			used[info.Name()] = true
+
+			data, err := ioutil.ReadFile(paths[info])
+			if err != nil {
+				return err
+			}
+			if err := os.MkdirAll(filepath.Join(dir, e.Name), 0755); err != nil {
+				return err
+			}
+			if err := ioutil.WriteFile(filepath.Join(dir, e.Name, info.Name()), data, 0644); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 func usage() {
 	// TODO Merge makemake.go into this
-	fmt.Fprintf(os.Stderr, "Usage: %s [doc|test] ...\n"+
+	fmt.Fprintf(os.Stderr, "Usage: %s [doc|test|tier|all|corpus] ...\n"+
 		"  doc <output>\n"+
-		"  test <output> <pom.xml> [<grammar.g4> ...]\n", filepath.Base(os.Args[0]))
+		"  test <output> <pom.xml> [<grammar.g4> ...]\n"+
+		"  tier <output> <passed|flaky>\n"+
+		"  all <output>\n"+
+		"  corpus <output>\n", filepath.Base(os.Args[0]))
 	os.Exit(1)
 }
 
@@ -243,8 +1253,8 @@ func main() {
 	typ := os.Args[1]
 	output := os.Args[2]
 
-	if typ != "doc" && typ != "test" {
-		log.Fatalf("Type must be one of doc, test, got: %q", typ)
+	if typ != "doc" && typ != "test" && typ != "tier" && typ != "all" && typ != "corpus" {
+		log.Fatalf("Type must be one of doc, test, tier, all, corpus, got: %q", typ)
 	}
 
 	copyrightTmpl := template.Must(template.New("copyright").Parse(COPYRIGHT))
@@ -279,7 +1289,69 @@ func main() {
 			project.AddGrammar(arg)
 		}
 
+		// Lint the grammar. The problems are counted and reported by the Makefile.
+		problems, err := project.Lint()
+		if err != nil {
+			log.Printf("lint: failed: %s", err)
+		}
+		for _, problem := range problems {
+			fmt.Println("lint:", problem)
+		}
+
+		entryPoints, err := readEntryPoints(ENTRYPOINTS_FILE)
+		if err != nil {
+			log.Fatalf("Failed to read entry points: %s", err)
+		}
+		if entryPoint, ok := entryPoints[output]; ok {
+			project.EntryPoint = entryPoint
+		}
+
+		unicodeInputs, err := readUnicodeInputs(UNICODE_FILE)
+		if err != nil {
+			log.Fatalf("Failed to read unicode inputs: %s", err)
+		}
+		data.UnicodeInputs = unicodeInputs[output]
+
+		if data.Representative, err = readRepresentative(filepath.Join(output, REPRESENTATIVE_FILE), project.Examples); err != nil {
+			log.Fatalf("Failed to read representative examples: %s", err)
+		}
+
+		// Check before generating anything, rather than creating tests that
+		// fail to compile.
+		if err := checkGenerated(output, project); err != nil {
+			log.Fatalf("%s: %s", typ, err)
+		}
+		if err := validateEntryPoint(output, project); err != nil {
+			log.Fatalf("%s: %s", typ, err)
+		}
+
 		data.Project = project
+		// Until the tests have run, and "make.go tier" records their outcome.
+		data.Tier = tierOf(TEST_PASSED, len(project.Examples) > 0)
+
+		if data.Flags, err = flagVars(output); err != nil {
+			log.Fatalf("%s: %s", typ, err)
+		}
+
+		data.ANTLRVersion = ANTLR_VERSION
+		data.ANTLRJar = ANTLR_JAR
+		if data.Generate, err = generateDirectives(project); err != nil {
+			log.Fatalf("%s: %s", typ, err)
+		}
+
+		for _, filename := range project.Includes {
+			license, err := internal.ParseLicense(filename)
+			if err != nil {
+				log.Fatalf("Failed to read license of %q: %s", filename, err)
+			}
+			if license != nil {
+				data.Licenses = append(data.Licenses, grammars.License{
+					Filename: filename,
+					SPDX:     license.SPDX,
+					Text:     license.Text,
+				})
+			}
+		}
 
 		funcs := template.FuncMap{
 			"Join":    strings.Join,
@@ -290,10 +1362,101 @@ func main() {
 		tmpl = template.Must(copyrightTmpl.New("test").Funcs(funcs).Parse(TESTFILE))
 		target = filepath.Join(output, output+"_test.go")
 
+		// The grammar is registered at the same time, as it is built from the same pom.
+		registerTmpl := template.Must(copyrightTmpl.New("register").Funcs(funcs).Parse(REGISTERFILE))
+		if err := create(filepath.Join(output, "register.go"), registerTmpl, data); err != nil {
+			log.Fatalf("%s: %s", typ, err)
+		}
+
+		aggregator := filepath.Join(output, "aggregator.go")
+		if project.HasParser() {
+			if data.Aggregator, data.Contexts, err = aggregatorContexts(output); err != nil {
+				log.Fatalf("%s: %s", typ, err)
+			}
+			aggregatorTmpl := template.Must(copyrightTmpl.New("aggregator").Parse(AGGREGATORFILE))
+			if err := create(aggregator, aggregatorTmpl, data); err != nil {
+				log.Fatalf("%s: %s", typ, err)
+			}
+		} else if err := os.Remove(aggregator); err != nil && !os.IsNotExist(err) {
+			log.Fatalf("%s: %s", typ, err)
+		}
+
 	} else if typ == "doc" {
 		tmpl = template.Must(copyrightTmpl.New("doc").Parse(DOCFILE))
 		target = filepath.Join(output, "doc.go")
 
+	} else if typ == "all" {
+		// Only include the grammars that were registered, and passed their
+		// tests (which is when the doc.go is created).
+		docs, err := filepath.Glob("*/doc.go")
+		if err != nil {
+			log.Fatalf("Failed to find grammars: %s", err)
+		}
+		for _, doc := range docs {
+			dir := filepath.Dir(doc)
+			if _, err := os.Stat(filepath.Join(dir, "register.go")); err == nil {
+				data.Packages = append(data.Packages, dir)
+			}
+		}
+
+		if err := os.MkdirAll(output, 0755); err != nil {
+			log.Fatalf("Failed to create %q: %s", output, err)
+		}
+
+		shardOf, shards, err := readShards(SHARDS_FILE)
+		if err != nil {
+			log.Fatalf("Failed to read shards: %s", err)
+		}
+		data.Shards = shards
+
+		// Every shard is written, even if empty, so none are left stale.
+		shardTmpl := template.Must(copyrightTmpl.New("shard").Parse(SHARDFILE))
+		for _, shard := range shards {
+			shardData := &templateData{
+				Shard:  shard,
+				Shards: shards,
+			}
+			for _, pkg := range data.Packages {
+				if shardOf(pkg) == shard {
+					shardData.Packages = append(shardData.Packages, pkg)
+				}
+			}
+			if err := create(filepath.Join(output, shard+".go"), shardTmpl, shardData); err != nil {
+				log.Fatalf("%s: %s", typ, err)
+			}
+		}
+
+		tmpl = template.Must(copyrightTmpl.New("all").Parse(ALLFILE))
+		target = filepath.Join(output, "all.go")
+
+		// The index is written at the same time, so both list the same grammars.
+		passed := make(map[string]bool)
+		for _, pkg := range data.Packages {
+			passed[pkg] = true
+		}
+		idx, err := buildIndex(passed)
+		if err != nil {
+			log.Fatalf("Failed to build the index: %s", err)
+		}
+		if err := writeIndex(grammars.IndexFilename, idx); err != nil {
+			log.Fatalf("Failed to write %q: %s", grammars.IndexFilename, err)
+		}
+
+	} else if typ == "tier" {
+		if len(os.Args) < 4 {
+			usage()
+		}
+		if err := writeTier(output, os.Args[3]); err != nil {
+			log.Fatalf("%s: %s", typ, err)
+		}
+		return
+
+	} else if typ == "corpus" {
+		if err := writeCorpus(filepath.Join(output, "examples")); err != nil {
+			log.Fatalf("%s: %s", typ, err)
+		}
+		return
+
 	} else {
 		panic(fmt.Sprintf("Unexpected type %q want doc or test", typ))
 	}
@@ -17,6 +17,7 @@
 package main
 
 import (
+	"bramp.net/antlr4/grammars"
 	"bramp.net/antlr4/internal"
 
 	"log"
@@ -26,14 +27,25 @@ import (
 	"text/template"
 )
 
+// IGNORE_PATHS are files that aren't grammars, or are variants of a grammar for
+// other targets. Whole grammars are disabled in grammars.Disabled instead.
 var IGNORE_PATHS = []string{"/antlr4/examples/",
 	"/CSharpSharwell/", "/Python/", "/CSharp/", "/JavaScript/", "/two-step-processing/",
-	"/python2-js/", "/python3-cs/", "/python3-js/", "/python3-py/", "/python3-ts/",
 	".TypeScriptTarget.", ".JavaScriptTarget.", ".PythonTarget.",
-	"/LexBasic.g4", "ecmascript/ECMAScript.g4", "/kotlin-formal/", "/turtle-doc/"}
+	"/LexBasic.g4", "ecmascript/ECMAScript.g4"}
+
+func init() {
+	for _, d := range grammars.Disabled() {
+		IGNORE_PATHS = append(IGNORE_PATHS, "/"+d.Name+"/")
+	}
+}
 
 const GRAMMARS_ROOT = "grammars-v4"
 
+// LOCAL_ROOT contains grammars authored outside of grammars-v4, see
+// newgrammar.go. It is optional.
+const LOCAL_ROOT = "grammars-local"
+
 // MAKEFILE is the template used to build the Makefile.
 // It expects to be executed with a templateData
 const MAKEFILE = `# Copyright 2017 Google Inc.
@@ -55,7 +67,7 @@ const MAKEFILE = `# Copyright 2017 Google Inc.
 #
 MAKEFLAGS += --no-builtin-rules --warn-undefined-variables
 
-.PHONY: all clean rebuild test
+.PHONY: all clean rebuild test fetch new-grammar generate-examples dedup-examples bench-runtime
 .DEFAULT_GOAL := all
 .SILENT:
 .DELETE_ON_ERROR:
@@ -63,7 +75,7 @@ MAKEFLAGS += --no-builtin-rules --warn-undefined-variables
 
 #ANTLR_BIN := $(PWD)/.bin/antlr-4.7-complete.jar
 ANTLR_URL := http://www.antlr.org/download/antlr-4.7-complete.jar
-ANTLR_BIN := $(HOME)/.m2/repository/org/antlr/antlr4/4.7.2/antlr4-4.7.2-complete.jar
+ANTLR_BIN := $(HOME)/.m2/repository/org/antlr/antlr4/{{ .ANTLRVersion }}/antlr4-{{ .ANTLRVersion }}-complete.jar
 ANTLR := java -jar $(ANTLR_BIN) -Dlanguage=Go -listener -no-visitor
 
 GRAMMARS :={{ range $name, $grammar := .Grammars }} {{$name}}{{ end }}
@@ -73,10 +85,13 @@ NO_COLOR   = \033[m
 
 XLOG=printf "| %s  | $(LANG_COLOR)%-15s$(NO_COLOR) | %-75s |\n" "❌"
 LOG=printf "| %s  | $(LANG_COLOR)%-15s$(NO_COLOR) | %-75s |\n" "✅"
+WLOG=printf "| %s  | $(LANG_COLOR)%-15s$(NO_COLOR) | %-75s |\n" "⚠️"
 
 # This is the default target (which cleans and rebuilds everything)
 all: Makefile
-	$(MAKE) -k -j2 rebuild 2> /dev/null
+	-$(MAKE) -k -j2 rebuild 2> /dev/null
+	go run internal/tools/make.go all grammars/all
+	go run internal/tools/make.go corpus grammars/corpus
 
 clean:
 	-rm -r $(GRAMMARS) 2> /dev/null
@@ -86,6 +101,9 @@ rebuild: $(GRAMMARS)
 $(GRAMMARS): $(ANTLR_BIN)
 	$(LOG) "$@"
 
+# The generated tests also depend on the tables make.go reads while writing them.
+$(foreach g,$(GRAMMARS),$(g)/$(g)_test.go): internal/tools/entrypoints.txt internal/tools/unicode.txt
+
 $(ANTLR_BIN):
 	mkdir -p .bin
 	curl -o $@ $(ANTLR_URL)
@@ -97,6 +115,30 @@ grammars-v4:
 	git submodule init
 	git submodule update
 
+# Download grammars-v4 at the commit pinned in grammars-v4.lock, instead of
+# using the submodule.
+fetch:
+	go run internal/tools/fetch.go
+
+# Scaffold a locally authored grammar, e.g "make new-grammar NAME=MyLang".
+new-grammar:
+	go run internal/tools/newgrammar.go $(NAME)
+
+# Synthesize inputs covering the rules a grammar's examples don't, e.g
+# "make generate-examples NAME=json". Run after make, as it reads grammars.json.
+generate-examples:
+	go run internal/tools/generate.go $(NAME)
+
+# Select a representative subset of a grammar's examples for its tests, e.g
+# "make dedup-examples NAME=sql/plsql". Run make afterwards to regenerate them.
+dedup-examples:
+	go run internal/tools/dedup.go $(NAME)
+
+# Compare the speed of two versions of the ANTLR Go runtime, e.g
+# "make bench-runtime OLD=4.7.2 NEW=master".
+bench-runtime:
+	go run internal/tools/runtimebench.go $(OLD) $(NEW)
+
 # Define build as a "function" so it can be called over and over
 # This could have been a standard target, but each Grammar depends on
 # and creates a slightly different set of named files. This makes it
@@ -106,6 +148,8 @@ BUILD=sh -c '\
 	basedir=$$PWD; \
 	errors=$$0/$$(basename $$1).log; \
 	mkdir -p $$0; \
+	outputs=$$(echo "$$@" | cut -s -d" " -f2-); \
+	rm -f $$outputs; \
 	pushd $$(dirname $$1) > /dev/null; \
 	$(ANTLR) -package $$0 $$(basename $$1) -o $$basedir/$$0 > $$basedir/$$errors 2>&1; \
 	RET=$$?; \
@@ -114,7 +158,12 @@ BUILD=sh -c '\
 		$(XLOG) "$$0" "antlr: $$(tail -n 1 $$errors)"; \
 		exit $$RET; \
 	fi; \
-	shift; shift; \
+	for f in $$outputs; do \
+		if [ ! -f $$f ]; then \
+			$(XLOG) "$$0" "antlr: $$f was not generated, see GeneratedFilenames"; \
+			exit 1; \
+		fi; \
+	done; \
 	go build ./$$0 >> $$errors 2>&1; \
 	RET=$$?; \
 	if [ $$RET -ne 0 ]; then \
@@ -130,11 +179,27 @@ TEST=sh -c '\
 		$(XLOG) "$$0" "maketest: $$(tail -n 1 $$errors)"; \
 		exit $$RET; \
 	fi; \
+	outcome=passed; \
 	go test -timeout 30s -count 3 ./$$0 >> $$errors 2>&1; \
 	RET=$$?; \
+	if [ $$RET -ne 0 ]; then \
+		outcome=flaky; \
+		go test -timeout 30s -count 3 ./$$0 >> $$errors 2>&1; \
+		RET=$$?; \
+	fi; \
 	if [ $$RET -ne 0 ]; then \
 		$(XLOG) "$$0" "test: $$(tail -n 1 $$errors)"; \
 		exit $$RET; \
+	fi; \
+	go run internal/tools/make.go tier $$0 $$outcome >> $$errors 2>&1; \
+	RET=$$?; \
+	if [ $$RET -ne 0 ]; then \
+		$(XLOG) "$$0" "tier: $$(tail -n 1 $$errors)"; \
+		exit $$RET; \
+	fi; \
+	warnings=$$(grep -c "^lint: " $$errors); \
+	if [ $$warnings -gt 0 ]; then \
+		$(WLOG) "$$0" "lint: $$warnings warnings, see $$errors"; \
 	fi;'
 
 %/doc.go:
@@ -161,7 +226,8 @@ TEST=sh -c '\
 `
 
 type templateData struct {
-	Grammars map[string][]*internal.Grammar
+	Grammars     map[string][]*internal.Grammar
+	ANTLRVersion string
 }
 
 func containAny(name string, any []string) bool {
@@ -173,11 +239,9 @@ func containAny(name string, any []string) bool {
 	return false
 }
 
-func main() {
-	g4s := make(map[string][]*internal.Grammar)
-
-	// Find all g4 files
-	err := filepath.Walk(GRAMMARS_ROOT, func(path string, info os.FileInfo, err error) error {
+// walkGrammars adds all the g4 files found under root to g4s.
+func walkGrammars(root string, g4s map[string][]*internal.Grammar) {
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
 
 		// Ignore some paths
 		if containAny(path, IGNORE_PATHS) {
@@ -203,6 +267,14 @@ func main() {
 	if err != nil {
 		log.Fatalf("failed to walk: %s", err)
 	}
+}
+
+func main() {
+	g4s := make(map[string][]*internal.Grammar)
+
+	// Find all g4 files
+	walkGrammars(GRAMMARS_ROOT, g4s)
+	walkGrammars(LOCAL_ROOT, g4s)
 
 	// Merge Parser and Lexer into same package
 	for name, files := range g4s {
@@ -245,7 +317,8 @@ func main() {
 	}
 
 	data := templateData{
-		Grammars: g4s,
+		Grammars:     g4s,
+		ANTLRVersion: internal.ANTLRVersion,
 	}
 
 	funcs := template.FuncMap{
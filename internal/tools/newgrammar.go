@@ -0,0 +1,218 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// newgrammar creates the skeleton of a locally authored grammar, so it is
+// built, tested and registered along with the grammars from grammars-v4.
+//
+// Usage:
+//
+//	go run internal/tools/newgrammar.go <GrammarName>
+//
+// which creates:
+//
+//	grammars-local/<name>/<GrammarName>.g4      the grammar
+//	grammars-local/<name>/pom.xml               its name, entry point and examples
+//	grammars-local/<name>/examples/example1.txt a example input for the tests
+//	grammars/tokenclass/overrides_<name>.go     corrections to the token classes
+//
+// then regenerates the Makefile, so "make <name>" builds, tests and
+// registers it.
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"text/template"
+)
+
+const LOCAL_ROOT = "grammars-local"
+
+var validName = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9]*$`)
+
+type grammarData struct {
+	Name        string // Name of the grammar, e.g "MyLang"
+	PackageName string // e.g "mylang"
+}
+
+var files = map[string]*template.Template{
+	"{{ .Name }}.g4": template.Must(template.New("g4").Parse(`grammar {{ .Name }};
+
+// file is the entry point, as named in pom.xml.
+file
+   : statement* EOF
+   ;
+
+statement
+   : ID '=' value ';'
+   ;
+
+value
+   : STRING
+   | NUMBER
+   | ID
+   ;
+
+ID
+   : [a-zA-Z_] [a-zA-Z_0-9]*
+   ;
+
+STRING
+   : '"' ~ ["\r\n]* '"'
+   ;
+
+NUMBER
+   : [0-9]+
+   ;
+
+COMMENT
+   : '#' ~ [\r\n]* -> channel (HIDDEN)
+   ;
+
+WS
+   : [ \t\r\n]+ -> skip
+   ;
+`)),
+
+	"pom.xml": template.Must(template.New("pom").Parse(`<?xml version="1.0" encoding="UTF-8"?>
+<project xmlns="http://maven.apache.org/POM/4.0.0" xmlns:xsi="http://www.w3.org/2001/XMLSchema-instance" xsi:schemaLocation="http://maven.apache.org/POM/4.0.0 http://maven.apache.org/xsd/maven-4.0.0.xsd">
+	<modelVersion>4.0.0</modelVersion>
+	<artifactId>{{ .PackageName }}</artifactId>
+	<packaging>jar</packaging>
+	<name>{{ .Name }} grammar</name>
+	<build>
+		<plugins>
+			<plugin>
+				<groupId>org.antlr</groupId>
+				<artifactId>antlr4-maven-plugin</artifactId>
+				<configuration>
+					<sourceDirectory>${basedir}</sourceDirectory>
+					<includes>
+						<include>{{ .Name }}.g4</include>
+					</includes>
+				</configuration>
+			</plugin>
+			<plugin>
+				<groupId>com.khubla.antlr</groupId>
+				<artifactId>antlr4test-maven-plugin</artifactId>
+				<configuration>
+					<verbose>false</verbose>
+					<showTree>false</showTree>
+					<entryPoint>file</entryPoint>
+					<grammarName>{{ .Name }}</grammarName>
+					<packageName></packageName>
+					<exampleFiles>examples/</exampleFiles>
+				</configuration>
+			</plugin>
+		</plugins>
+	</build>
+</project>
+`)),
+
+	"examples/example1.txt": template.Must(template.New("example").Parse(`# A example {{ .Name }} file, parsed by the generated tests.
+name = "{{ .Name }}";
+answer = 42;
+`)),
+}
+
+var overridesTmpl = template.Must(template.New("overrides").Parse(`// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tokenclass
+
+func init() {
+	// Corrections to the guessed class of the {{ .Name }} grammar's tokens,
+	// keyed by symbolic token name, e.g "ID": Identifier.
+	overrides[{{ printf "%q" .PackageName }}] = map[string]Class{}
+}
+`))
+
+func create(filename string, t *template.Template, data *grammarData) error {
+	if err := os.MkdirAll(filepath.Dir(filename), 0755); err != nil {
+		return err
+	}
+
+	out, err := os.OpenFile(filename, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("failed to create %q: %s", filename, err)
+	}
+	if err := t.Execute(out, data); err != nil {
+		out.Close()
+		return fmt.Errorf("failed to generate %q: %s", filename, err)
+	}
+	if err := out.Close(); err != nil {
+		return fmt.Errorf("failed to close file %q: %s", filename, err)
+	}
+
+	fmt.Println("Created", filename)
+	return nil
+}
+
+func main() {
+	if len(os.Args) != 2 || !validName.MatchString(os.Args[1]) {
+		fmt.Fprintf(os.Stderr, "Usage: %s <GrammarName>\n", filepath.Base(os.Args[0]))
+		os.Exit(2)
+	}
+
+	data := &grammarData{
+		Name:        os.Args[1],
+		PackageName: strings.ToLower(os.Args[1]),
+	}
+
+	// The package is created at the top level, so can't clash with anything
+	// else there, such as a grammar from grammars-v4.
+	if _, err := os.Stat(data.PackageName); err == nil {
+		log.Fatalf("%q already exists", data.PackageName)
+	}
+	dir := filepath.Join(LOCAL_ROOT, data.PackageName)
+	if _, err := os.Stat(dir); err == nil {
+		log.Fatalf("%q already exists", dir)
+	}
+
+	for name, t := range files {
+		filename := filepath.Join(dir, strings.Replace(name, "{{ .Name }}", data.Name, 1))
+		if err := create(filename, t, data); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	overrides := filepath.Join("grammars", "tokenclass", "overrides_"+data.PackageName+".go")
+	if err := create(overrides, overridesTmpl, data); err != nil {
+		log.Fatal(err)
+	}
+
+	cmd := exec.Command("go", "run", "internal/tools/makemake.go")
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		log.Fatalf("Failed to regenerate the Makefile: %s", err)
+	}
+
+	fmt.Printf("\nEdit the grammar and examples, then build, test and register it with:\n\n\tmake %s && make\n", data.PackageName)
+}
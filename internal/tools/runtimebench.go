@@ -0,0 +1,301 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// runtimebench compares the speed of two versions of the ANTLR Go runtime,
+// to guide upgrading it. It runs the benchmarks in internal/runtimebench once
+// against each version, and reports the difference in time and allocations
+// for each example, and overall.
+//
+// Usage:
+//
+//	go run internal/tools/runtimebench.go [-grammars json,xml] [-tags grammars_data] [-count N] <old> <new>
+//
+// The old and new versions are git refs (such as tags or commits) of the
+// antlr4 repository, which is found in GOPATH unless given by -antlr. Each
+// version's runtime is copied into a temporary GOPATH, that takes precedence
+// over the rest of GOPATH. The runtimes must be compatible with the code
+// generated by the ANTLR version in internal/tools/make.go.
+//
+// The raw benchmark output of each version is also written to -out, so it can
+// be compared with benchstat for more rigorous statistics.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"go/build"
+	"io"
+	"io/ioutil"
+	"log"
+	"math"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	BENCH_PACKAGE  = "bramp.net/antlr4/internal/runtimebench"
+	RUNTIME_IMPORT = "github.com/antlr/antlr4/runtime/Go/antlr"
+	RUNTIME_DIR    = "runtime/Go/antlr" // Relative to the antlr4 repository
+)
+
+var (
+	antlrRepo   = flag.String("antlr", "", "path of the antlr4 git repository (default found in GOPATH)")
+	names       = flag.String("grammars", "json", "comma separated names of the grammars to benchmark, or empty for all")
+	tags        = flag.String("tags", "", "extra build tags, such as a grammars/all shard, or corpus")
+	count       = flag.Int("count", 5, "number of times to run each benchmark")
+	benchtime   = flag.String("benchtime", "1s", "time to run each benchmark for")
+	outputDir   = flag.String("out", "runtimebench", "directory to write each version's benchmark output")
+	benchLineRe = regexp.MustCompile(`^(Benchmark\S+?)(?:-\d+)?\s+\d+\s+(.*)$`)
+)
+
+// measurement is the mean of a benchmark's runs.
+type measurement struct {
+	NsPerOp     float64
+	AllocsPerOp float64
+	runs        int
+}
+
+// findRepo returns the root of the antlr4 repository containing the runtime
+// in GOPATH.
+func findRepo() (string, error) {
+	pkg, err := build.Import(RUNTIME_IMPORT, "", build.FindOnly)
+	if err != nil {
+		return "", fmt.Errorf("unable to find the antlr4 repository, use -antlr: %s", err)
+	}
+	return strings.TrimSuffix(filepath.ToSlash(pkg.Dir), "/"+RUNTIME_DIR), nil
+}
+
+// refName returns the git ref, such as "origin/master", as a filename.
+func refName(ref string) string {
+	return strings.Replace(ref, "/", "_", -1)
+}
+
+// checkout copies the runtime at the git ref into a new GOPATH under dir, and
+// returns it.
+func checkout(repo, ref, dir string) (string, error) {
+	gopath := filepath.Join(dir, refName(ref))
+	dst := filepath.Join(gopath, "src", "github.com", "antlr", "antlr4")
+	if err := os.MkdirAll(dst, 0755); err != nil {
+		return "", err
+	}
+
+	archive := exec.Command("git", "-C", repo, "archive", "--format=tar", ref, RUNTIME_DIR)
+	extract := exec.Command("tar", "-x", "-C", dst)
+	pipe, err := archive.StdoutPipe()
+	if err != nil {
+		return "", err
+	}
+	extract.Stdin = pipe
+	archive.Stderr = os.Stderr
+	extract.Stderr = os.Stderr
+
+	if err := extract.Start(); err != nil {
+		return "", err
+	}
+	if err := archive.Run(); err != nil {
+		return "", fmt.Errorf("git archive %s: %s", ref, err)
+	}
+	if err := extract.Wait(); err != nil {
+		return "", fmt.Errorf("extracting %s: %s", ref, err)
+	}
+	return gopath, nil
+}
+
+// run runs the benchmarks with the runtime in gopath, writing their output to
+// the filename, and returns the measurements.
+func run(gopath, filename string) (map[string]*measurement, error) {
+	args := []string{
+		"test",
+		"-tags", strings.TrimSpace("runtimebench " + *tags),
+		"-run", "NONE",
+		"-bench", ".",
+		"-benchtime", *benchtime,
+		"-count", strconv.Itoa(*count),
+		BENCH_PACKAGE,
+		"-args", "-grammars", *names,
+	}
+	cmd := exec.Command("go", args...)
+	cmd.Env = append(os.Environ(),
+		"GOPATH="+gopath+string(filepath.ListSeparator)+build.Default.GOPATH,
+		"GO111MODULE=off",
+	)
+	cmd.Stderr = os.Stderr
+
+	out, err := os.Create(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer out.Close()
+
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, err
+	}
+	results, err := parseBench(io.TeeReader(stdout, out))
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Wait(); err != nil {
+		return nil, fmt.Errorf("go test: %s", err)
+	}
+	return results, nil
+}
+
+// parseBench returns the mean of each benchmark in the go test output.
+func parseBench(r io.Reader) (map[string]*measurement, error) {
+	results := make(map[string]*measurement)
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		m := benchLineRe.FindStringSubmatch(scanner.Text())
+		if m == nil {
+			continue
+		}
+
+		// The values are pairs of numbers and units, e.g "123 ns/op".
+		fields := strings.Fields(m[2])
+		var ns, allocs float64
+		for i := 0; i+1 < len(fields); i += 2 {
+			v, err := strconv.ParseFloat(fields[i], 64)
+			if err != nil {
+				continue
+			}
+			switch fields[i+1] {
+			case "ns/op":
+				ns = v
+			case "allocs/op":
+				allocs = v
+			}
+		}
+
+		result := results[m[1]]
+		if result == nil {
+			result = &measurement{}
+			results[m[1]] = result
+		}
+		// Keep a running mean.
+		result.runs++
+		result.NsPerOp += (ns - result.NsPerOp) / float64(result.runs)
+		result.AllocsPerOp += (allocs - result.AllocsPerOp) / float64(result.runs)
+	}
+	return results, scanner.Err()
+}
+
+// delta returns the change from before to after as a percentage.
+func delta(before, after float64) string {
+	if before == 0 {
+		return "~"
+	}
+	return fmt.Sprintf("%+.1f%%", (after-before)/before*100)
+}
+
+// report writes a table comparing the benchmarks run with both versions, and
+// the geometric mean of their ratios.
+func report(w io.Writer, oldRef, newRef string, before, after map[string]*measurement) error {
+	var names []string
+	for name := range before {
+		if _, found := after[name]; found {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	if len(names) == 0 {
+		return fmt.Errorf("no benchmarks were run with both versions")
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(tw, "Benchmark\t%s ns/op\t%s ns/op\tDelta\t%s allocs/op\t%s allocs/op\tDelta\t\n", oldRef, newRef, oldRef, newRef)
+
+	logRatio := 0.0
+	for _, name := range names {
+		o, n := before[name], after[name]
+		fmt.Fprintf(tw, "%s\t%.0f\t%.0f\t%s\t%.0f\t%.0f\t%s\t\n",
+			strings.TrimPrefix(name, "BenchmarkParse/"),
+			o.NsPerOp, n.NsPerOp, delta(o.NsPerOp, n.NsPerOp),
+			o.AllocsPerOp, n.AllocsPerOp, delta(o.AllocsPerOp, n.AllocsPerOp))
+		if o.NsPerOp > 0 && n.NsPerOp > 0 {
+			logRatio += math.Log(n.NsPerOp / o.NsPerOp)
+		}
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	geomean := math.Exp(logRatio / float64(len(names)))
+	_, err := fmt.Fprintf(w, "\n%s is %.2fx the speed of %s (geometric mean of %d benchmarks)\n",
+		newRef, 1/geomean, oldRef, len(names))
+	return err
+}
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage: go run internal/tools/runtimebench.go [flags] <old ref> <new ref>\n")
+	flag.PrintDefaults()
+	os.Exit(2)
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() != 2 {
+		usage()
+	}
+	oldRef, newRef := flag.Arg(0), flag.Arg(1)
+
+	repo := *antlrRepo
+	if repo == "" {
+		var err error
+		if repo, err = findRepo(); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	tmp, err := ioutil.TempDir("", "runtimebench")
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer os.RemoveAll(tmp)
+
+	if err := os.MkdirAll(*outputDir, 0755); err != nil {
+		log.Fatal(err)
+	}
+
+	var results []map[string]*measurement
+	for _, ref := range []string{oldRef, newRef} {
+		gopath, err := checkout(repo, ref, tmp)
+		if err != nil {
+			log.Fatalf("%s: %s", ref, err)
+		}
+
+		filename := filepath.Join(*outputDir, refName(ref)+".txt")
+		log.Printf("Benchmarking %s, writing %s", ref, filename)
+		r, err := run(gopath, filename)
+		if err != nil {
+			log.Fatalf("%s: %s", ref, err)
+		}
+		results = append(results, r)
+	}
+
+	if err := report(os.Stdout, oldRef, newRef, results[0], results[1]); err != nil {
+		log.Fatal(err)
+	}
+}
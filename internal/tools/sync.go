@@ -0,0 +1,261 @@
+// Copyright 2017 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     https://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// sync updates the grammars-v4 submodule to a new commit, reports which
+// grammars were added, removed or modified, regenerates only those, and
+// summarises which grammars' tests were fixed or broken.
+//
+// Usage:
+//
+//	go run internal/tools/sync.go [-no-build] [<commit>]
+//
+// where commit defaults to origin/master.
+package main
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+const GRAMMARS_ROOT = "grammars-v4"
+
+var noBuild = flag.Bool("no-build", false, "update the checkout and Makefile, and report the changes, without regenerating any grammars")
+
+// git runs a git command in the grammars-v4 checkout, returning its output.
+func git(args ...string) (string, error) {
+	cmd := exec.Command("git", append([]string{"-C", GRAMMARS_ROOT}, args...)...)
+	cmd.Stderr = os.Stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return "", fmt.Errorf("git %s: %s", strings.Join(args, " "), err)
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// run runs the command, sending its output to ours.
+func run(name string, args ...string) error {
+	cmd := exec.Command(name, args...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%s %s: %s", name, strings.Join(args, " "), err)
+	}
+	return nil
+}
+
+// readMakefile returns the files in grammars-v4 (the pom.xml and g4s) each
+// grammar is built from, by reading the test rules makemake.go generated.
+func readMakefile(path string) (map[string][]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	grammars := make(map[string][]string)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		// e.g "	${TEST} json grammars-v4/json/pom.xml grammars-v4/json/JSON.g4"
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 3 || fields[0] != "${TEST}" {
+			continue
+		}
+		grammars[fields[1]] = fields[2:]
+	}
+	return grammars, scanner.Err()
+}
+
+// affected returns true if the changed file is used to build, or test, the
+// grammar. That is, if it is one of the grammar's files, or is within the
+// directory of its pom.xml (such as the examples).
+func affected(files []string, changed string) bool {
+	for _, f := range files {
+		if f == changed {
+			return true
+		}
+		if filepath.Base(f) == "pom.xml" && strings.HasPrefix(changed, filepath.Dir(f)+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// passing returns true if the grammar was built, and its tests passed, which
+// is when the Makefile creates its doc.go.
+func passing(name string) bool {
+	_, err := os.Stat(filepath.Join(name, "doc.go"))
+	return err == nil
+}
+
+func sorted(m map[string]bool) []string {
+	var s []string
+	for name := range m {
+		s = append(s, name)
+	}
+	sort.Strings(s)
+	return s
+}
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: %s [-no-build] [<commit>]\n", filepath.Base(os.Args[0]))
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	target := "origin/master"
+	if flag.NArg() > 1 {
+		flag.Usage()
+		os.Exit(2)
+	} else if flag.NArg() == 1 {
+		target = flag.Arg(0)
+	}
+
+	before, err := readMakefile("Makefile")
+	if err != nil {
+		log.Fatalf("Failed to read Makefile: %s", err)
+	}
+	wasPassing := make(map[string]bool)
+	for name := range before {
+		wasPassing[name] = passing(name)
+	}
+
+	// Update the checkout.
+	from, err := git("rev-parse", "HEAD")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if _, err := git("fetch", "origin"); err != nil {
+		log.Fatal(err)
+	}
+	if _, err := git("checkout", "--quiet", target); err != nil {
+		log.Fatal(err)
+	}
+	to, err := git("rev-parse", "HEAD")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	diff, err := git("diff", "--name-only", from, to)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var changed []string
+	for _, f := range strings.Fields(diff) {
+		changed = append(changed, filepath.Join(GRAMMARS_ROOT, f))
+	}
+
+	// Regenerate the Makefile, to find the new set of grammars.
+	if err := run("go", "run", "internal/tools/makemake.go"); err != nil {
+		log.Fatal(err)
+	}
+	after, err := readMakefile("Makefile")
+	if err != nil {
+		log.Fatalf("Failed to read Makefile: %s", err)
+	}
+
+	added := make(map[string]bool)
+	removed := make(map[string]bool)
+	modified := make(map[string]bool)
+	for name, files := range after {
+		if _, found := before[name]; !found {
+			added[name] = true
+			continue
+		}
+		for _, c := range changed {
+			if affected(files, c) || affected(before[name], c) {
+				modified[name] = true
+				break
+			}
+		}
+	}
+	for name := range before {
+		if _, found := after[name]; !found {
+			removed[name] = true
+		}
+	}
+
+	fmt.Printf("grammars-v4: %.7s -> %.7s (%d files changed)\n", from, to, len(changed))
+	fmt.Printf("  added:    %s\n", strings.Join(sorted(added), " "))
+	fmt.Printf("  removed:  %s\n", strings.Join(sorted(removed), " "))
+	fmt.Printf("  modified: %s\n", strings.Join(sorted(modified), " "))
+
+	if *noBuild {
+		return
+	}
+
+	// Remove the old generated code, and rebuild only what changed.
+	var rebuild []string
+	for name := range removed {
+		if err := os.RemoveAll(name); err != nil {
+			log.Fatalf("Failed to remove %q: %s", name, err)
+		}
+	}
+	for name := range modified {
+		if err := os.RemoveAll(name); err != nil {
+			log.Fatalf("Failed to remove %q: %s", name, err)
+		}
+		rebuild = append(rebuild, name)
+	}
+	for name := range added {
+		rebuild = append(rebuild, name)
+	}
+	sort.Strings(rebuild)
+
+	if len(rebuild) > 0 {
+		// Failures are expected, and reported below, so ignore the error.
+		run("make", append([]string{"-k", "-j2"}, rebuild...)...)
+	}
+	if err := run("go", "run", "internal/tools/make.go", "all", "grammars/all"); err != nil {
+		log.Fatal(err)
+	}
+
+	// Summarise the test impact.
+	fixed := make(map[string]bool)
+	broken := make(map[string]bool)
+	failing := make(map[string]bool)
+	for _, name := range rebuild {
+		switch now := passing(name); {
+		case now && !wasPassing[name]:
+			fixed[name] = true
+		case !now && wasPassing[name]:
+			broken[name] = true
+		case !now:
+			failing[name] = true
+		}
+	}
+	lost := make(map[string]bool)
+	for name := range removed {
+		if wasPassing[name] {
+			lost[name] = true
+		}
+	}
+
+	fmt.Println("Test impact:")
+	fmt.Printf("  now passing:   %s\n", strings.Join(sorted(fixed), " "))
+	fmt.Printf("  now failing:   %s\n", strings.Join(sorted(broken), " "))
+	fmt.Printf("  still failing: %s\n", strings.Join(sorted(failing), " "))
+	fmt.Printf("  removed:       %s\n", strings.Join(sorted(lost), " "))
+
+	if len(broken) > 0 || len(lost) > 0 {
+		os.Exit(1)
+	}
+}
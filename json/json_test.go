@@ -36,6 +36,12 @@ var examples = []string{
 	"grammars-v4/json/examples/example1.json",
 }
 
+// unicodeInputs contain characters outside the Basic Multilingual Plane.
+var unicodeInputs = []string{
+	"{\"\U0001f600\": \"\U0002000b\"}",
+	"[\"a\U0001f44d\U0001f3fdb\", 1]",
+}
+
 type exampleListener struct {
 	*json.BaseJSONListener
 }
@@ -61,6 +67,17 @@ func Example() {
 	antlr.ParseTreeWalkerDefault.Walk(&exampleListener{}, tree)
 }
 
+// generated returns the inputs written by internal/tools/generate.go to cover
+// the rules the examples don't, relative to the root of this repository like
+// the examples.
+func generated() []string {
+	files, _ := filepath.Glob(filepath.Join("testdata", "generated", "*"))
+	for i, file := range files {
+		files[i] = filepath.Join("json", file)
+	}
+	return files
+}
+
 func newCharStream(filename string) (antlr.CharStream, error) {
 	var input antlr.CharStream
 	input, err := antlr.NewFileStream(filepath.Join("..", filename))
@@ -72,7 +89,7 @@ func newCharStream(filename string) (antlr.CharStream, error) {
 }
 
 func TestJSONLexer(t *testing.T) {
-	for _, file := range examples {
+	for _, file := range append(examples, generated()...) {
 		input, err := newCharStream(file)
 		if err != nil {
 			t.Errorf("Failed to open example file: %s", err)
@@ -97,10 +114,43 @@ func TestJSONLexer(t *testing.T) {
 	}
 }
 
+func TestJSONLexerUnicode(t *testing.T) {
+	for _, s := range unicodeInputs {
+		runes := []rune(s)
+		var input antlr.CharStream = antlr.NewInputStream(s)
+
+		lexer := json.NewJSONLexer(input)
+		lexer.RemoveErrorListeners()
+		lexer.AddErrorListener(internal.NewTestingErrorListener(t, s))
+
+		stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+
+		p := json.NewJSONParser(stream)
+		p.RemoveErrorListeners()
+		p.AddErrorListener(internal.NewTestingErrorListener(t, s))
+		p.Json()
+
+		for _, tok := range stream.GetAllTokens() {
+			// Token positions are indexes of code points, not bytes or UTF-16 units.
+			start, stop := tok.GetStart(), tok.GetStop()
+			if tok.GetTokenType() == antlr.TokenEOF || stop < start {
+				continue
+			}
+			if stop >= len(runes) {
+				t.Errorf("NewJSONLexer(%+q) token %+q stops at %d, beyond the end of the input", s, tok.GetText(), stop)
+				continue
+			}
+			if want := string(runes[start : stop+1]); tok.GetText() != want {
+				t.Errorf("NewJSONLexer(%+q) token %+q, want %+q", s, tok.GetText(), want)
+			}
+		}
+	}
+}
+
 func TestJSONParser(t *testing.T) {
 	// TODO(bramp): Run this test with and without p.BuildParseTrees
 
-	for _, file := range examples {
+	for _, file := range append(examples, generated()...) {
 		input, err := newCharStream(file)
 		if err != nil {
 			t.Errorf("Failed to open example file: %s", err)
@@ -37,6 +37,11 @@ var examples = []string{
 	"grammars-v4/xml/examples/web.xml",
 }
 
+// unicodeInputs contain characters outside the Basic Multilingual Plane.
+var unicodeInputs = []string{
+	"<a x=\"\U0001f600\">\U0002000b</a>",
+}
+
 type exampleListener struct {
 	*xml.BaseXMLParserListener
 }
@@ -62,6 +67,17 @@ func Example() {
 	antlr.ParseTreeWalkerDefault.Walk(&exampleListener{}, tree)
 }
 
+// generated returns the inputs written by internal/tools/generate.go to cover
+// the rules the examples don't, relative to the root of this repository like
+// the examples.
+func generated() []string {
+	files, _ := filepath.Glob(filepath.Join("testdata", "generated", "*"))
+	for i, file := range files {
+		files[i] = filepath.Join("xml", file)
+	}
+	return files
+}
+
 func newCharStream(filename string) (antlr.CharStream, error) {
 	var input antlr.CharStream
 	input, err := antlr.NewFileStream(filepath.Join("..", filename))
@@ -73,7 +89,7 @@ func newCharStream(filename string) (antlr.CharStream, error) {
 }
 
 func TestXMLLexer(t *testing.T) {
-	for _, file := range examples {
+	for _, file := range append(examples, generated()...) {
 		input, err := newCharStream(file)
 		if err != nil {
 			t.Errorf("Failed to open example file: %s", err)
@@ -98,10 +114,43 @@ func TestXMLLexer(t *testing.T) {
 	}
 }
 
+func TestXMLLexerUnicode(t *testing.T) {
+	for _, s := range unicodeInputs {
+		runes := []rune(s)
+		var input antlr.CharStream = antlr.NewInputStream(s)
+
+		lexer := xml.NewXMLLexer(input)
+		lexer.RemoveErrorListeners()
+		lexer.AddErrorListener(internal.NewTestingErrorListener(t, s))
+
+		stream := antlr.NewCommonTokenStream(lexer, antlr.TokenDefaultChannel)
+
+		p := xml.NewXMLParser(stream)
+		p.RemoveErrorListeners()
+		p.AddErrorListener(internal.NewTestingErrorListener(t, s))
+		p.Document()
+
+		for _, tok := range stream.GetAllTokens() {
+			// Token positions are indexes of code points, not bytes or UTF-16 units.
+			start, stop := tok.GetStart(), tok.GetStop()
+			if tok.GetTokenType() == antlr.TokenEOF || stop < start {
+				continue
+			}
+			if stop >= len(runes) {
+				t.Errorf("NewXMLLexer(%+q) token %+q stops at %d, beyond the end of the input", s, tok.GetText(), stop)
+				continue
+			}
+			if want := string(runes[start : stop+1]); tok.GetText() != want {
+				t.Errorf("NewXMLLexer(%+q) token %+q, want %+q", s, tok.GetText(), want)
+			}
+		}
+	}
+}
+
 func TestXMLParser(t *testing.T) {
 	// TODO(bramp): Run this test with and without p.BuildParseTrees
 
-	for _, file := range examples {
+	for _, file := range append(examples, generated()...) {
 		input, err := newCharStream(file)
 		if err != nil {
 			t.Errorf("Failed to open example file: %s", err)